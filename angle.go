@@ -132,3 +132,27 @@ func Atan(v float32) AngleRadian {
 func Atan2(y, x float32) AngleRadian {
 	return AngleRadian(math.Atan2(float64(y), float64(x)))
 }
+
+// AngleDegreeSmoothDamp smooth damp by the shortest path, currentVelocity is degree per second
+func AngleDegreeSmoothDamp(current AngleDegree, target AngleDegree, currentVelocity *float32, smoothTime float32, maxSpeed float32, deltaTime float32) AngleDegree {
+	target = current + AngleDegreeDelta(current, target)
+	ret := F32SmoothDamp(current.ToFloat32(), target.ToFloat32(), currentVelocity, smoothTime, maxSpeed, deltaTime)
+	return AngleDegree(ret)
+}
+
+// AngleRadianSmoothDamp smooth damp by the shortest path, currentVelocity is radian per second
+func AngleRadianSmoothDamp(current AngleRadian, target AngleRadian, currentVelocity *float32, smoothTime float32, maxSpeed float32, deltaTime float32) AngleRadian {
+	target = current + AngleRadianDelta(current, target)
+	ret := F32SmoothDamp(current.ToFloat32(), target.ToFloat32(), currentVelocity, smoothTime, maxSpeed, deltaTime)
+	return AngleRadian(ret)
+}
+
+// AngleDegreeDamp exponential decay by the shortest path
+func AngleDegreeDamp(current AngleDegree, target AngleDegree, halfLife float32, deltaTime float32) AngleDegree {
+	return current + AngleDegreeDelta(current, target).Multiply(F32DampFactor(halfLife, deltaTime))
+}
+
+// AngleRadianDamp exponential decay by the shortest path
+func AngleRadianDamp(current AngleRadian, target AngleRadian, halfLife float32, deltaTime float32) AngleRadian {
+	return current + AngleRadianDelta(current, target).Multiply(F32DampFactor(halfLife, deltaTime))
+}
@@ -22,4 +22,18 @@ func TestAngle(t *testing.T) {
 	if vDegree != 20 {
 		t.Error("AngleDegreeMoveTowards")
 	}
+
+	// wraps around 360 by the shortest path
+	var velocity float32
+	vDegree = 350
+	for i := 0; i < 100; i++ {
+		vDegree = AngleDegreeSmoothDamp(vDegree, 10, &velocity, 0.3, 1000, 0.05)
+		if vDegree < 350 {
+			t.Error("AngleDegreeSmoothDamp")
+			break
+		}
+	}
+	if !F32Equal2(AngleDegreeDelta(vDegree, 10).ToFloat32(), 0, 1e-3) {
+		t.Error("AngleDegreeSmoothDamp")
+	}
 }
@@ -0,0 +1,178 @@
+package gmath
+
+type BezierQuadratic struct {
+	P0 Vector3 `json:"p0"`
+	P1 Vector3 `json:"p1"`
+	P2 Vector3 `json:"p2"`
+}
+
+// BezierCubic all the curves are converted to it
+type BezierCubic struct {
+	P0 Vector3 `json:"p0"`
+	P1 Vector3 `json:"p1"`
+	P2 Vector3 `json:"p2"`
+	P3 Vector3 `json:"p3"`
+}
+
+// Evaluate t [0,1]
+func (b BezierQuadratic) Evaluate(t float32) Vector3 {
+	s := 1 - t
+	ret := b.P0.Scale(s * s)
+	ret.AddSelf(b.P1.Scale(2 * s * t))
+	ret.AddSelf(b.P2.Scale(t * t))
+	return ret
+}
+
+func (b BezierQuadratic) Derivative(t float32) Vector3 {
+	d0 := b.P1.Substract(b.P0)
+	d1 := b.P2.Substract(b.P1)
+	return V3LerpUnclamped(d0, d1, t).Scale(2)
+}
+
+// Split at t, return [0,t] and [t,1]
+func (b BezierQuadratic) Split(t float32) (BezierQuadratic, BezierQuadratic) {
+	p01 := V3LerpUnclamped(b.P0, b.P1, t)
+	p12 := V3LerpUnclamped(b.P1, b.P2, t)
+	p := V3LerpUnclamped(p01, p12, t)
+	return BezierQuadratic{b.P0, p01, p}, BezierQuadratic{p, p12, b.P2}
+}
+
+// ToCubic degree elevation, the curve is the same
+func (b BezierQuadratic) ToCubic() BezierCubic {
+	return BezierCubic{
+		P0: b.P0,
+		P1: V3LerpUnclamped(b.P0, b.P1, 2.0/3.0),
+		P2: V3LerpUnclamped(b.P2, b.P1, 2.0/3.0),
+		P3: b.P2,
+	}
+}
+
+// BezierCubicFromHermite tangents are the derivatives at p0 and p1
+func BezierCubicFromHermite(p0 Vector3, tangent0 Vector3, p1 Vector3, tangent1 Vector3) BezierCubic {
+	return BezierCubic{
+		P0: p0,
+		P1: p0.Add(tangent0.Scale(1.0 / 3.0)),
+		P2: p1.Substract(tangent1.Scale(1.0 / 3.0)),
+		P3: p1,
+	}
+}
+
+// BezierCubicFromCatmullRom centripetal, the segment from p1 to p2
+func BezierCubicFromCatmullRom(p0 Vector3, p1 Vector3, p2 Vector3, p3 Vector3) BezierCubic {
+	dt0, dt1, dt2 := _CatmullRomIntervals(p0, p1, p2, p3)
+	tangent1, tangent2 := _CatmullRomTangents(p0, p1, p2, p3, dt0, dt1, dt2)
+	return BezierCubicFromHermite(p1, tangent1.Scale(dt1), p2, tangent2.Scale(dt1))
+}
+
+// BezierCubicFromBSpline uniform, the segment between p1 and p2
+func BezierCubicFromBSpline(p0 Vector3, p1 Vector3, p2 Vector3, p3 Vector3) BezierCubic {
+	start := p0.Add(p1.Scale(4))
+	start.AddSelf(p2)
+	end := p1.Add(p2.Scale(4))
+	end.AddSelf(p3)
+	return BezierCubic{
+		P0: start.Scale(1.0 / 6.0),
+		P1: p2.Add(p1.Scale(2)).Scale(1.0 / 3.0),
+		P2: p1.Add(p2.Scale(2)).Scale(1.0 / 3.0),
+		P3: end.Scale(1.0 / 6.0),
+	}
+}
+
+// Evaluate t [0,1]
+func (b BezierCubic) Evaluate(t float32) Vector3 {
+	s := 1 - t
+	ret := b.P0.Scale(s * s * s)
+	ret.AddSelf(b.P1.Scale(3 * s * s * t))
+	ret.AddSelf(b.P2.Scale(3 * s * t * t))
+	ret.AddSelf(b.P3.Scale(t * t * t))
+	return ret
+}
+
+func (b BezierCubic) Derivative(t float32) Vector3 {
+	s := 1 - t
+	d0 := b.P1.Substract(b.P0)
+	d1 := b.P2.Substract(b.P1)
+	d2 := b.P3.Substract(b.P2)
+	ret := d0.Scale(3 * s * s)
+	ret.AddSelf(d1.Scale(6 * s * t))
+	ret.AddSelf(d2.Scale(3 * t * t))
+	return ret
+}
+
+func (b BezierCubic) SecondDerivative(t float32) Vector3 {
+	dd0 := b.P0.Add(b.P2.Substract(b.P1.Scale(2)))
+	dd1 := b.P1.Add(b.P3.Substract(b.P2.Scale(2)))
+	return V3LerpUnclamped(dd0, dd1, t).Scale(6)
+}
+
+// Split at t, return [0,t] and [t,1]
+func (b BezierCubic) Split(t float32) (BezierCubic, BezierCubic) {
+	p01 := V3LerpUnclamped(b.P0, b.P1, t)
+	p12 := V3LerpUnclamped(b.P1, b.P2, t)
+	p23 := V3LerpUnclamped(b.P2, b.P3, t)
+	p012 := V3LerpUnclamped(p01, p12, t)
+	p123 := V3LerpUnclamped(p12, p23, t)
+	p := V3LerpUnclamped(p012, p123, t)
+	return BezierCubic{b.P0, p01, p012, p}, BezierCubic{p, p123, p23, b.P3}
+}
+
+// ClosestPoint return t [0,1] and the point
+func (b BezierCubic) ClosestPoint(point Vector3) (float32, Vector3) {
+	const samples = 16
+
+	// coarse
+	var bestT float32
+	bestDistance := V3DistanceSqr(b.P0, point)
+	for i := 1; i <= samples; i++ {
+		t := float32(i) / samples
+		d := V3DistanceSqr(b.Evaluate(t), point)
+		if d < bestDistance {
+			bestT, bestDistance = t, d
+		}
+	}
+
+	// newton's method on (B(t)-point).B'(t) = 0
+	t := bestT
+	for i := 0; i < 8; i++ {
+		offset := b.Evaluate(t).Substract(point)
+		d1 := b.Derivative(t)
+		numerator := offset.Dot(d1)
+		denominator := d1.Dot(d1) + offset.Dot(b.SecondDerivative(t))
+		if F32IsZero2(denominator, 1e-12) {
+			break
+		}
+		t = F32Clamp01(t - numerator/denominator)
+	}
+	if d := V3DistanceSqr(b.Evaluate(t), point); d < bestDistance {
+		bestT = t
+	}
+	return bestT, b.Evaluate(bestT)
+}
+
+// _CatmullRomIntervals the centripetal knot intervals, sqrt of the distances
+func _CatmullRomIntervals(p0, p1, p2, p3 Vector3) (float32, float32, float32) {
+	dt0 := F32Sqrt(V3Distance(p0, p1))
+	dt1 := F32Sqrt(V3Distance(p1, p2))
+	dt2 := F32Sqrt(V3Distance(p2, p3))
+	if dt1 < 1e-4 {
+		dt1 = 1
+	}
+	if dt0 < 1e-4 {
+		dt0 = dt1
+	}
+	if dt2 < 1e-4 {
+		dt2 = dt1
+	}
+	return dt0, dt1, dt2
+}
+
+// _CatmullRomTangents the derivatives at p1 and p2 by the knot parameter
+func _CatmullRomTangents(p0, p1, p2, p3 Vector3, dt0, dt1, dt2 float32) (Vector3, Vector3) {
+	tangent1 := p1.Substract(p0).Scale(1 / dt0).
+		Substract(p2.Substract(p0).Scale(1 / (dt0 + dt1)))
+	tangent1.AddSelf(p2.Substract(p1).Scale(1 / dt1))
+	tangent2 := p2.Substract(p1).Scale(1 / dt1).
+		Substract(p3.Substract(p1).Scale(1 / (dt1 + dt2)))
+	tangent2.AddSelf(p3.Substract(p2).Scale(1 / dt2))
+	return tangent1, tangent2
+}
@@ -0,0 +1,148 @@
+package gmath
+
+import "math"
+
+// Bounds axis aligned bounding box, same as Unity's Bounds
+type Bounds struct {
+	Center  Vector3 `json:"center"`
+	Extents Vector3 `json:"extents"`
+}
+
+// BoundsCenterSize size is the full size, not the extents
+func BoundsCenterSize(center Vector3, size Vector3) Bounds {
+	return Bounds{center, size.Scale(0.5)}
+}
+
+func BoundsMinMax(min Vector3, max Vector3) Bounds {
+	return Bounds{V3LerpUnclamped(min, max, 0.5), max.Substract(min).Scale(0.5)}
+}
+
+// BoundsFromPoints return zero bounds if points is empty
+func BoundsFromPoints(points []Vector3) Bounds {
+	if len(points) == 0 {
+		return Bounds{}
+	}
+	min, max := points[0], points[0]
+	for _, p := range points[1:] {
+		min = V3Min(min, p)
+		max = V3Max(max, p)
+	}
+	return BoundsMinMax(min, max)
+}
+
+func (bounds Bounds) Min() Vector3 {
+	return bounds.Center.Substract(bounds.Extents)
+}
+
+func (bounds Bounds) Max() Vector3 {
+	return bounds.Center.Add(bounds.Extents)
+}
+
+func (bounds Bounds) Size() Vector3 {
+	return bounds.Extents.Scale(2)
+}
+
+func (bounds Bounds) Contains(point Vector3) bool {
+	min, max := bounds.Min(), bounds.Max()
+	return point.X >= min.X && point.X <= max.X &&
+		point.Y >= min.Y && point.Y <= max.Y &&
+		point.Z >= min.Z && point.Z <= max.Z
+}
+
+// Intersects overlap of two bounds, touching counts
+func (bounds Bounds) Intersects(other Bounds) bool {
+	return F32Abs(bounds.Center.X-other.Center.X) <= bounds.Extents.X+other.Extents.X &&
+		F32Abs(bounds.Center.Y-other.Center.Y) <= bounds.Extents.Y+other.Extents.Y &&
+		F32Abs(bounds.Center.Z-other.Center.Z) <= bounds.Extents.Z+other.Extents.Z
+}
+
+// ContainsBounds other is completely inside
+func (bounds Bounds) ContainsBounds(other Bounds) bool {
+	min, max := bounds.Min(), bounds.Max()
+	otherMin, otherMax := other.Min(), other.Max()
+	return otherMin.X >= min.X && otherMax.X <= max.X &&
+		otherMin.Y >= min.Y && otherMax.Y <= max.Y &&
+		otherMin.Z >= min.Z && otherMax.Z <= max.Z
+}
+
+func (bounds Bounds) Encapsulate(point Vector3) Bounds {
+	return BoundsMinMax(V3Min(bounds.Min(), point), V3Max(bounds.Max(), point))
+}
+
+func (bounds Bounds) EncapsulateBounds(other Bounds) Bounds {
+	return BoundsMinMax(V3Min(bounds.Min(), other.Min()), V3Max(bounds.Max(), other.Max()))
+}
+
+// Expand grow the size by amount, the extents by amount/2, same as Unity
+func (bounds Bounds) Expand(amount float32) Bounds {
+	amount *= 0.5
+	return Bounds{bounds.Center, bounds.Extents.Add(Vector3{amount, amount, amount})}
+}
+
+func (bounds Bounds) ClosestPoint(point Vector3) Vector3 {
+	min, max := bounds.Min(), bounds.Max()
+	return Vector3{F32Clamp(point.X, min.X, max.X), F32Clamp(point.Y, min.Y, max.Y), F32Clamp(point.Z, min.Z, max.Z)}
+}
+
+// SqrDistance zero if the point is inside
+func (bounds Bounds) SqrDistance(point Vector3) float32 {
+	return V3DistanceSqr(bounds.ClosestPoint(point), point)
+}
+
+// Transform the bounds of the transformed box, enlarged if the matrix rotates
+func (bounds Bounds) Transform(matrix *Matrix4) Bounds {
+	center := matrix.MultiplyPoint3x4(bounds.Center)
+	e := bounds.Extents
+	extents := Vector3{
+		F32Abs(matrix.m00)*e.X + F32Abs(matrix.m01)*e.Y + F32Abs(matrix.m02)*e.Z,
+		F32Abs(matrix.m10)*e.X + F32Abs(matrix.m11)*e.Y + F32Abs(matrix.m12)*e.Z,
+		F32Abs(matrix.m20)*e.X + F32Abs(matrix.m21)*e.Y + F32Abs(matrix.m22)*e.Z,
+	}
+	return Bounds{center, extents}
+}
+
+// Raycast hit at distance 0 if the origin is inside
+func (bounds Bounds) Raycast(ray Ray) (RaycastHit, bool) {
+	min := [3]float32{bounds.Center.X - bounds.Extents.X, bounds.Center.Y - bounds.Extents.Y, bounds.Center.Z - bounds.Extents.Z}
+	max := [3]float32{bounds.Center.X + bounds.Extents.X, bounds.Center.Y + bounds.Extents.Y, bounds.Center.Z + bounds.Extents.Z}
+	origin := [3]float32{ray.Origin.X, ray.Origin.Y, ray.Origin.Z}
+	dir := [3]float32{ray.Direction.X, ray.Direction.Y, ray.Direction.Z}
+
+	var tMin float32
+	var tMax float32 = math.MaxFloat32
+	normal := ray.Direction.Scale(-1)
+	for axis := 0; axis < 3; axis++ {
+		if F32IsZero2(dir[axis], 1e-12) {
+			if origin[axis] < min[axis] || origin[axis] > max[axis] {
+				return RaycastHit{}, false
+			}
+			continue
+		}
+
+		inv := 1 / dir[axis]
+		t1 := (min[axis] - origin[axis]) * inv
+		t2 := (max[axis] - origin[axis]) * inv
+		var sign float32 = -1
+		if t1 > t2 {
+			t1, t2 = t2, t1
+			sign = 1
+		}
+		if t1 > tMin {
+			tMin = t1
+			normal = V3Zero()
+			switch axis {
+			case 0:
+				normal.X = sign
+			case 1:
+				normal.Y = sign
+			case 2:
+				normal.Z = sign
+			}
+		}
+		tMax = F32Min(tMax, t2)
+		if tMin > tMax {
+			return RaycastHit{}, false
+		}
+	}
+	return _NewRaycastHit(ray, tMin, normal), true
+}
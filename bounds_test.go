@@ -0,0 +1,128 @@
+package gmath
+
+import "testing"
+
+func TestBoundsTransform(t *testing.T) {
+	bounds := BoundsMinMax(Vector3{-1, -1, -1}, Vector3{1, 1, 1})
+	m := Matrix4TRS(Vector3{10, 0, 0}, QuaternionAngleAxis(AngleDegree(45).ToRadian(), V3Up()), V3One())
+	result := bounds.Transform(&m)
+	sqrt2 := F32Sqrt(2)
+	if !result.Center.Equal(Vector3{10, 0, 0}) || !result.Extents.Equal(Vector3{sqrt2, 1, sqrt2}) {
+		t.Error("Bounds.Transform")
+	}
+
+	obb := OBBFromBounds(bounds).Transform(&m)
+	if !obb.GetBounds().Extents.Equal(result.Extents) || !obb.Contains(Vector3{10 + 1.4, 0, 0}) || obb.Contains(Vector3{11.4, 0, 1}) {
+		t.Error("OBB.Transform")
+	}
+
+	plane := PlaneFromNormalPoint(V3Up(), Vector3{0, 1, 0}).Transform(&m)
+	if !plane.Normal.Equal(V3Up()) || !F32Equal(plane.Distance, -1) {
+		t.Error("Plane.Transform")
+	}
+}
+
+func TestRaycast(t *testing.T) {
+	ray := NewRay(Vector3{-10, 0, 0}, V3Right())
+
+	if hit, ok := (Bounds{V3Zero(), V3One()}).Raycast(ray); !ok || !F32Equal(hit.Distance, 9) || !hit.Normal.Equal(V3Left()) {
+		t.Error("Bounds.Raycast")
+	}
+	if _, ok := (Bounds{Vector3{0, 2, 0}, V3One()}).Raycast(ray); ok {
+		t.Error("Bounds.Raycast miss")
+	}
+	if hit, ok := (Sphere{Vector3{0, 0, 0}, 2}).Raycast(ray); !ok || !F32Equal(hit.Distance, 8) || !hit.Normal.Equal(V3Left()) {
+		t.Error("Sphere.Raycast")
+	}
+	if hit, ok := PlaneFromNormalPoint(V3Right(), Vector3{5, 0, 0}).Raycast(ray); !ok || !F32Equal(hit.Distance, 15) || !hit.Normal.Equal(V3Left()) {
+		t.Error("Plane.Raycast")
+	}
+
+	obb := OBB{V3Zero(), V3One(), QuaternionAngleAxis(AngleDegree(45).ToRadian(), V3Up())}
+	if hit, ok := obb.Raycast(ray); !ok || !F32Equal2(hit.Distance, 10-F32Sqrt(2), 1e-4) || hit.Normal.X >= 0 || !F32Equal(hit.Normal.Magnitude(), 1) {
+		t.Error("OBB.Raycast")
+	}
+
+	capsule := Capsule{Vector3{0, -1, 0}, Vector3{0, 1, 0}, 0.5}
+	if hit, ok := capsule.Raycast(ray); !ok || !F32Equal(hit.Distance, 9.5) || !hit.Normal.Equal(V3Left()) {
+		t.Error("Capsule.Raycast")
+	}
+	down := NewRay(Vector3{0, 10, 0}, V3Down())
+	if hit, ok := capsule.Raycast(down); !ok || !F32Equal(hit.Distance, 8.5) || !hit.Normal.Equal(V3Up()) {
+		t.Error("Capsule.Raycast cap")
+	}
+
+	triangle := Triangle{Vector3{0, -1, -1}, Vector3{0, 1, -1}, Vector3{0, 0, 1}}
+	if hit, ok := triangle.Raycast(ray); !ok || !F32Equal(hit.Distance, 10) || !hit.Normal.Equal(V3Left()) {
+		t.Error("Triangle.Raycast")
+	}
+	if _, ok := triangle.Raycast(NewRay(Vector3{-10, 0, 2}, V3Right())); ok {
+		t.Error("Triangle.Raycast miss")
+	}
+}
+
+func TestOverlap(t *testing.T) {
+	sphere := Sphere{Vector3{0, 0, 0}, 1}
+	bounds := Bounds{Vector3{2.5, 0, 0}, V3One()}
+	capsule := Capsule{Vector3{0, 2, 0}, Vector3{5, 2, 0}, 1.1}
+	obb := OBB{Vector3{2.5, 0, 0}, V3One(), QuaternionAngleAxis(AngleDegree(45).ToRadian(), V3Up())}
+
+	if OverlapSphereBounds(sphere, bounds) || !OverlapSphereBounds(Sphere{sphere.Center, 1.6}, bounds) {
+		t.Error("OverlapSphereBounds")
+	}
+	// the rotated box reaches 2.5-1.414 on x
+	if !OverlapSphereOBB(Sphere{sphere.Center, 1.2}, obb) || OverlapSphereOBB(sphere, obb) {
+		t.Error("OverlapSphereOBB")
+	}
+	if !OverlapSphereCapsule(sphere, capsule) || OverlapSphereCapsule(Sphere{sphere.Center, 0.8}, capsule) {
+		t.Error("OverlapSphereCapsule")
+	}
+	if !OverlapBoundsCapsule(bounds, capsule) || OverlapBoundsCapsule(bounds, Capsule{Vector3{0, 2, 0}, Vector3{5, 2, 0}, 0.9}) {
+		t.Error("OverlapBoundsCapsule")
+	}
+	if !OverlapOBBCapsule(obb, capsule) || OverlapOBBCapsule(obb, Capsule{Vector3{0, 2, 0}, Vector3{5, 2, 0}, 0.9}) {
+		t.Error("OverlapOBBCapsule")
+	}
+	if !OverlapBoundsOBB(Bounds{Vector3{0.2, 0, 0}, V3One()}, obb) || OverlapBoundsOBB(Bounds{Vector3{0, 0, 0}, Vector3{0.5, 1, 0.5}}, obb) {
+		t.Error("OverlapBoundsOBB")
+	}
+	// edge-edge: two boxes rotated around different axes
+	a := OBB{V3Zero(), V3One(), QuaternionAngleAxis(AngleDegree(45).ToRadian(), V3Up())}
+	b := OBB{Vector3{3, 0, 0}, V3One(), QuaternionAngleAxis(AngleDegree(45).ToRadian(), V3Forward())}
+	if OverlapOBBOBB(a, b) || !OverlapOBBOBB(a, OBB{Vector3{2.7, 0, 0}, V3One(), b.Rotation}) {
+		t.Error("OverlapOBBOBB")
+	}
+	crossed := Capsule{Vector3{2, 4, -5}, Vector3{2, 4, 5}, 1}
+	if !OverlapCapsuleCapsule(capsule, crossed) || OverlapCapsuleCapsule(capsule, Capsule{crossed.Start, crossed.End, 0.8}) {
+		t.Error("OverlapCapsuleCapsule")
+	}
+
+	triangle := Triangle{Vector3{-1, 0, -1}, Vector3{1, 0, -1}, Vector3{0, 0, 1}}
+	if !OverlapSphereTriangle(Sphere{Vector3{0, 0.5, 0}, 0.6}, triangle) || OverlapSphereTriangle(Sphere{Vector3{0, 0.5, 0}, 0.4}, triangle) {
+		t.Error("OverlapSphereTriangle")
+	}
+	// the closest point is the vertex B
+	if !OverlapSphereTriangle(Sphere{Vector3{3, 0, -1}, 2.1}, triangle) || OverlapSphereTriangle(Sphere{Vector3{3, 0, -1}, 1.9}, triangle) {
+		t.Error("OverlapSphereTriangle vertex")
+	}
+	if !OverlapCapsuleTriangle(Capsule{Vector3{0, -2, 0}, Vector3{0, 2, 0}, 0.1}, triangle) {
+		t.Error("OverlapCapsuleTriangle through")
+	}
+	if !OverlapCapsuleTriangle(Capsule{Vector3{-2, 1, 0}, Vector3{2, 1, 0}, 1.1}, triangle) || OverlapCapsuleTriangle(Capsule{Vector3{-2, 1, 0}, Vector3{2, 1, 0}, 0.9}, triangle) {
+		t.Error("OverlapCapsuleTriangle")
+	}
+	if !OverlapBoundsTriangle(Bounds{Vector3{0, 1, 0}, Vector3{0.5, 1.1, 0.5}}, triangle) || OverlapBoundsTriangle(Bounds{Vector3{0, 1, 0}, Vector3{0.5, 0.9, 0.5}}, triangle) {
+		t.Error("OverlapBoundsTriangle")
+	}
+	// only the edge crosses the box, no vertex is inside
+	if !OverlapBoundsTriangle(Bounds{V3Zero(), V3One()}, Triangle{Vector3{1.5, 0, 0}, Vector3{3, 0, 0}, Vector3{0, 0, 3}}) ||
+		OverlapBoundsTriangle(Bounds{V3Zero(), V3One()}, Triangle{Vector3{2.5, 0, 0}, Vector3{0, 0, 2.5}, Vector3{2.5, 0, 2.5}}) {
+		t.Error("OverlapBoundsTriangle edge")
+	}
+
+	plane := PlaneFromNormalPoint(V3Up(), V3Zero())
+	if plane.ClassifySphere(sphere) != PlaneSideIntersect || plane.ClassifyCapsule(capsule) != PlaneSideFront ||
+		plane.ClassifyBounds(Bounds{Vector3{0, -2, 0}, V3One()}) != PlaneSideBack || plane.ClassifyOBB(obb) != PlaneSideIntersect {
+		t.Error("Plane.Classify")
+	}
+}
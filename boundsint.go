@@ -0,0 +1,83 @@
+package gmath
+
+// BoundsInt cells in [Position, Position+Size)
+type BoundsInt struct {
+	Position Vector3Int `json:"position"`
+	Size     Vector3Int `json:"size"`
+}
+
+// BoundsIntMinMax max is exclusive
+func BoundsIntMinMax(min, max Vector3Int) BoundsInt {
+	return BoundsInt{min, max.Substract(min)}
+}
+
+func (bounds BoundsInt) Min() Vector3Int {
+	return bounds.Position
+}
+
+// Max exclusive
+func (bounds BoundsInt) Max() Vector3Int {
+	return bounds.Position.Add(bounds.Size)
+}
+
+func (bounds BoundsInt) IsEmpty() bool {
+	return bounds.Size.X <= 0 || bounds.Size.Y <= 0 || bounds.Size.Z <= 0
+}
+
+func (bounds BoundsInt) Volume() int {
+	if bounds.IsEmpty() {
+		return 0
+	}
+	return bounds.Size.X * bounds.Size.Y * bounds.Size.Z
+}
+
+func (bounds BoundsInt) Contains(cell Vector3Int) bool {
+	max := bounds.Max()
+	return cell.X >= bounds.Position.X && cell.X < max.X &&
+		cell.Y >= bounds.Position.Y && cell.Y < max.Y &&
+		cell.Z >= bounds.Position.Z && cell.Z < max.Z
+}
+
+func (bounds BoundsInt) Overlaps(other BoundsInt) bool {
+	_, ok := bounds.Intersect(other)
+	return ok
+}
+
+// Intersect return false if there is no common cell
+func (bounds BoundsInt) Intersect(other BoundsInt) (BoundsInt, bool) {
+	min := V3IntMax(bounds.Min(), other.Min())
+	max := V3IntMin(bounds.Max(), other.Max())
+	if min.X >= max.X || min.Y >= max.Y || min.Z >= max.Z {
+		return BoundsInt{}, false
+	}
+	return BoundsIntMinMax(min, max), true
+}
+
+// ClampCell the nearest cell inside bounds, bounds must not be empty
+func (bounds BoundsInt) ClampCell(cell Vector3Int) Vector3Int {
+	max := bounds.Max()
+	return Vector3Int{
+		X: _IntMax(bounds.Position.X, _IntMin(cell.X, max.X-1)),
+		Y: _IntMax(bounds.Position.Y, _IntMin(cell.Y, max.Y-1)),
+		Z: _IntMax(bounds.Position.Z, _IntMin(cell.Z, max.Z-1)),
+	}
+}
+
+// XZ drop the Y axis
+func (bounds BoundsInt) XZ() RectInt {
+	return RectInt{bounds.Position.XZ(), bounds.Size.XZ()}
+}
+
+// ForEach x first, then z, then y, stop if fn return false
+func (bounds BoundsInt) ForEach(fn func(cell Vector3Int) bool) {
+	max := bounds.Max()
+	for y := bounds.Position.Y; y < max.Y; y++ {
+		for z := bounds.Position.Z; z < max.Z; z++ {
+			for x := bounds.Position.X; x < max.X; x++ {
+				if !fn(Vector3Int{x, y, z}) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,364 @@
+package gmath
+
+import "sort"
+
+// BVH bounding volume hierarchy of a triangle mesh, built with the surface area heuristic
+type BVH struct {
+	vertices []Vector3
+	indices  []int
+	// the triangles ordered by the leaves
+	order []int
+	nodes []_BVHNode
+}
+
+// BVHRaycastHit Triangle is the index of the triangle, (U, V) is the barycentric, see Triangle.GetPoint
+type BVHRaycastHit struct {
+	RaycastHit
+	Triangle int
+	U        float32
+	V        float32
+}
+
+type _BVHNode struct {
+	min Vector3
+	max Vector3
+	// a leaf has the triangles order[start:start+count], an inner node has the children start and start+1
+	start int
+	count int
+}
+
+const (
+	_BVHBinCount = 16
+	_BVHLeafSize = 4
+)
+
+// NewBVH indices has 3 vertex indices per triangle, the slices are not copied
+func NewBVH(vertices []Vector3, indices []int) *BVH {
+	count := len(indices) / 3
+	bvh := &BVH{vertices: vertices, indices: indices, order: make([]int, count)}
+	for i := range bvh.order {
+		bvh.order[i] = i
+	}
+	if count == 0 {
+		return bvh
+	}
+
+	centroids := make([]Vector3, count)
+	bounds := make([]Bounds, count)
+	for i := range centroids {
+		triangle := bvh.Triangle(i)
+		bounds[i] = triangle.GetBounds()
+		centroids[i] = bounds[i].Center
+	}
+	bvh.nodes = make([]_BVHNode, 1, 2*count)
+	bvh._Build(0, 0, count, centroids, bounds)
+	return bvh
+}
+
+func (bvh *BVH) TriangleCount() int {
+	return len(bvh.order)
+}
+
+func (bvh *BVH) Triangle(index int) Triangle {
+	return Triangle{
+		bvh.vertices[bvh.indices[index*3]],
+		bvh.vertices[bvh.indices[index*3+1]],
+		bvh.vertices[bvh.indices[index*3+2]],
+	}
+}
+
+func (bvh *BVH) GetBounds() Bounds {
+	if len(bvh.nodes) == 0 {
+		return Bounds{}
+	}
+	return BoundsMinMax(bvh.nodes[0].min, bvh.nodes[0].max)
+}
+
+// Refit update the bounds after the vertices moved, the topology is kept, so the tree may degrade after large deformations
+func (bvh *BVH) Refit(vertices []Vector3) {
+	bvh.vertices = vertices
+	// the children are always after their parent
+	for i := len(bvh.nodes) - 1; i >= 0; i-- {
+		node := &bvh.nodes[i]
+		if node.count > 0 {
+			node.min, node.max = bvh._LeafBounds(node.start, node.count)
+		} else {
+			left, right := &bvh.nodes[node.start], &bvh.nodes[node.start+1]
+			node.min = V3Min(left.min, right.min)
+			node.max = V3Max(left.max, right.max)
+		}
+	}
+}
+
+// Raycast the closest hit within maxDistance, both faces can be hit
+func (bvh *BVH) Raycast(ray Ray, maxDistance float32) (BVHRaycastHit, bool) {
+	return bvh._Raycast(ray, maxDistance, false)
+}
+
+// RaycastAny any hit within maxDistance, cheaper than Raycast for line of sight
+func (bvh *BVH) RaycastAny(ray Ray, maxDistance float32) (BVHRaycastHit, bool) {
+	return bvh._Raycast(ray, maxDistance, true)
+}
+
+// OverlapSphere appends the indices of the triangles overlapping the sphere to result
+func (bvh *BVH) OverlapSphere(sphere Sphere, result []int) []int {
+	bounds := sphere.GetBounds()
+	return bvh._Overlap(bounds, result, func(triangle Triangle) bool { return OverlapSphereTriangle(sphere, triangle) })
+}
+
+// OverlapBounds appends the indices of the triangles overlapping bounds to result
+func (bvh *BVH) OverlapBounds(bounds Bounds, result []int) []int {
+	return bvh._Overlap(bounds, result, func(triangle Triangle) bool { return OverlapBoundsTriangle(bounds, triangle) })
+}
+
+// OverlapCapsule appends the indices of the triangles overlapping the capsule to result
+func (bvh *BVH) OverlapCapsule(capsule Capsule, result []int) []int {
+	return bvh._Overlap(capsule.GetBounds(), result, func(triangle Triangle) bool { return OverlapCapsuleTriangle(capsule, triangle) })
+}
+
+func (bvh *BVH) _Overlap(bounds Bounds, result []int, test func(triangle Triangle) bool) []int {
+	if len(bvh.nodes) == 0 {
+		return result
+	}
+	min, max := bounds.Min(), bounds.Max()
+	var buffer [64]int
+	stack := append(buffer[:0], 0)
+	for len(stack) > 0 {
+		node := &bvh.nodes[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		if node.min.X > max.X || node.max.X < min.X || node.min.Y > max.Y || node.max.Y < min.Y || node.min.Z > max.Z || node.max.Z < min.Z {
+			continue
+		}
+		if node.count == 0 {
+			stack = append(stack, node.start, node.start+1)
+			continue
+		}
+		for _, index := range bvh.order[node.start : node.start+node.count] {
+			if test(bvh.Triangle(index)) {
+				result = append(result, index)
+			}
+		}
+	}
+	return result
+}
+
+func (bvh *BVH) _Raycast(ray Ray, maxDistance float32, anyHit bool) (BVHRaycastHit, bool) {
+	var best BVHRaycastHit
+	found := false
+	if len(bvh.nodes) == 0 {
+		return best, false
+	}
+
+	invDir := Vector3{1 / ray.Direction.X, 1 / ray.Direction.Y, 1 / ray.Direction.Z}
+	var buffer [64]int
+	stack := append(buffer[:0], 0)
+	for len(stack) > 0 {
+		node := &bvh.nodes[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		if _, ok := _BVHRayBounds(node.min, node.max, ray.Origin, invDir, maxDistance); !ok {
+			continue
+		}
+
+		if node.count > 0 {
+			for _, index := range bvh.order[node.start : node.start+node.count] {
+				hit, u, v, ok := bvh.Triangle(index).RaycastBarycentric(ray)
+				if !ok || hit.Distance > maxDistance {
+					continue
+				}
+				best = BVHRaycastHit{hit, index, u, v}
+				found = true
+				if anyHit {
+					return best, true
+				}
+				maxDistance = hit.Distance
+			}
+			continue
+		}
+
+		// push the far child first
+		left, right := node.start, node.start+1
+		leftDistance, leftOk := _BVHRayBounds(bvh.nodes[left].min, bvh.nodes[left].max, ray.Origin, invDir, maxDistance)
+		rightDistance, rightOk := _BVHRayBounds(bvh.nodes[right].min, bvh.nodes[right].max, ray.Origin, invDir, maxDistance)
+		if leftOk && rightOk {
+			if leftDistance < rightDistance {
+				stack = append(stack, right, left)
+			} else {
+				stack = append(stack, left, right)
+			}
+		} else if leftOk {
+			stack = append(stack, left)
+		} else if rightOk {
+			stack = append(stack, right)
+		}
+	}
+	return best, found
+}
+
+// _BVHRayBounds slab test, invDir may be infinite
+func _BVHRayBounds(min, max, origin, invDir Vector3, maxDistance float32) (float32, bool) {
+	tMin, tMax := float32(0), maxDistance
+	for axis := uint8(0); axis < 3; axis++ {
+		inv := _V3Axis(invDir, axis)
+		o := _V3Axis(origin, axis)
+		t1 := (_V3Axis(min, axis) - o) * inv
+		t2 := (_V3Axis(max, axis) - o) * inv
+		// NaN if the origin is on the slab and the ray is parallel, ignore the axis
+		if t1 != t1 || t2 != t2 {
+			continue
+		}
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		tMin = F32Max(tMin, t1)
+		tMax = F32Min(tMax, t2)
+		if tMin > tMax {
+			return 0, false
+		}
+	}
+	return tMin, true
+}
+
+func (bvh *BVH) _LeafBounds(start, count int) (Vector3, Vector3) {
+	first := bvh.Triangle(bvh.order[start])
+	min := V3Min(first.A, V3Min(first.B, first.C))
+	max := V3Max(first.A, V3Max(first.B, first.C))
+	for _, index := range bvh.order[start+1 : start+count] {
+		triangle := bvh.Triangle(index)
+		min = V3Min(min, V3Min(triangle.A, V3Min(triangle.B, triangle.C)))
+		max = V3Max(max, V3Max(triangle.A, V3Max(triangle.B, triangle.C)))
+	}
+	return min, max
+}
+
+func _BVHHalfArea(extents Vector3) float32 {
+	return extents.X*extents.Y + extents.Y*extents.Z + extents.Z*extents.X
+}
+
+// _Build binned SAH, order[start:start+count] belongs to the node
+func (bvh *BVH) _Build(nodeIndex int, start int, count int, centroids []Vector3, bounds []Bounds) {
+	min, max := bvh._LeafBounds(start, count)
+	bvh.nodes[nodeIndex] = _BVHNode{min: min, max: max, start: start, count: count}
+	if count <= _BVHLeafSize {
+		return
+	}
+
+	centroidMin, centroidMax := centroids[bvh.order[start]], centroids[bvh.order[start]]
+	for _, index := range bvh.order[start+1 : start+count] {
+		centroidMin = V3Min(centroidMin, centroids[index])
+		centroidMax = V3Max(centroidMax, centroids[index])
+	}
+
+	bestCost := float32(count) * _BVHHalfArea(max.Substract(min))
+	bestAxis, bestSplit := -1, 0
+	for axis := uint8(0); axis < 3; axis++ {
+		lo, hi := _V3Axis(centroidMin, axis), _V3Axis(centroidMax, axis)
+		if hi-lo <= 0 {
+			continue
+		}
+		scale := _BVHBinCount / (hi - lo)
+
+		var binCounts [_BVHBinCount]int
+		var binMin, binMax [_BVHBinCount]Vector3
+		for _, index := range bvh.order[start : start+count] {
+			bin := _BVHBin(_V3Axis(centroids[index], axis), lo, scale)
+			if binCounts[bin] == 0 {
+				binMin[bin], binMax[bin] = bounds[index].Min(), bounds[index].Max()
+			} else {
+				binMin[bin] = V3Min(binMin[bin], bounds[index].Min())
+				binMax[bin] = V3Max(binMax[bin], bounds[index].Max())
+			}
+			binCounts[bin]++
+		}
+
+		// the cost of the right side of each split, sweeping from the right
+		var rightCost [_BVHBinCount]float32
+		rightCount := 0
+		var rightMin, rightMax Vector3
+		for bin := _BVHBinCount - 1; bin > 0; bin-- {
+			if binCounts[bin] > 0 {
+				if rightCount == 0 {
+					rightMin, rightMax = binMin[bin], binMax[bin]
+				} else {
+					rightMin, rightMax = V3Min(rightMin, binMin[bin]), V3Max(rightMax, binMax[bin])
+				}
+				rightCount += binCounts[bin]
+			}
+			rightCost[bin] = float32(rightCount) * _BVHHalfArea(rightMax.Substract(rightMin))
+		}
+		leftCount := 0
+		var leftMin, leftMax Vector3
+		for split := 1; split < _BVHBinCount; split++ {
+			bin := split - 1
+			if binCounts[bin] > 0 {
+				if leftCount == 0 {
+					leftMin, leftMax = binMin[bin], binMax[bin]
+				} else {
+					leftMin, leftMax = V3Min(leftMin, binMin[bin]), V3Max(leftMax, binMax[bin])
+				}
+				leftCount += binCounts[bin]
+			}
+			if leftCount == 0 || leftCount == count {
+				continue
+			}
+			cost := float32(leftCount)*_BVHHalfArea(leftMax.Substract(leftMin)) + rightCost[split]
+			if cost < bestCost {
+				bestCost, bestAxis, bestSplit = cost, int(axis), split
+			}
+		}
+	}
+	if bestAxis < 0 {
+		// splitting does not pay off, unless the leaf is too large
+		if count <= _BVHLeafSize*4 {
+			return
+		}
+		bvh._SplitMedian(start, count, centroids, centroidMin, centroidMax)
+	}
+
+	mid := start + count/2
+	if bestAxis >= 0 {
+		axis := uint8(bestAxis)
+		lo := _V3Axis(centroidMin, axis)
+		scale := _BVHBinCount / (_V3Axis(centroidMax, axis) - lo)
+		i, j := start, start+count-1
+		for i <= j {
+			if _BVHBin(_V3Axis(centroids[bvh.order[i]], axis), lo, scale) < bestSplit {
+				i++
+			} else {
+				bvh.order[i], bvh.order[j] = bvh.order[j], bvh.order[i]
+				j--
+			}
+		}
+		mid = i
+	}
+
+	left := len(bvh.nodes)
+	bvh.nodes = append(bvh.nodes, _BVHNode{}, _BVHNode{})
+	bvh.nodes[nodeIndex].start = left
+	bvh.nodes[nodeIndex].count = 0
+	bvh._Build(left, start, mid-start, centroids, bounds)
+	bvh._Build(left+1, mid, start+count-mid, centroids, bounds)
+}
+
+func _BVHBin(value float32, lo float32, scale float32) int {
+	bin := int((value - lo) * scale)
+	if bin >= _BVHBinCount {
+		bin = _BVHBinCount - 1
+	}
+	return bin
+}
+
+// _SplitMedian order the triangles by the centroid on the longest axis, the halves become the children
+func (bvh *BVH) _SplitMedian(start, count int, centroids []Vector3, centroidMin, centroidMax Vector3) {
+	extents := centroidMax.Substract(centroidMin)
+	var axis uint8
+	if extents.Y > extents.X {
+		axis = 1
+	}
+	if extents.Z > _V3Axis(extents, axis) {
+		axis = 2
+	}
+	order := bvh.order[start : start+count]
+	sort.Slice(order, func(i, j int) bool {
+		return _V3Axis(centroids[order[i]], axis) < _V3Axis(centroids[order[j]], axis)
+	})
+}
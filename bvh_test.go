@@ -0,0 +1,97 @@
+package gmath
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// _BVHTestGrid a height field of size x size quads
+func _BVHTestGrid(size int, height func(x, z int) float32) ([]Vector3, []int) {
+	vertices := make([]Vector3, 0, (size+1)*(size+1))
+	for z := 0; z <= size; z++ {
+		for x := 0; x <= size; x++ {
+			vertices = append(vertices, Vector3{float32(x), height(x, z), float32(z)})
+		}
+	}
+	indices := make([]int, 0, size*size*6)
+	for z := 0; z < size; z++ {
+		for x := 0; x < size; x++ {
+			i := z*(size+1) + x
+			indices = append(indices, i, i+size+1, i+1, i+1, i+size+1, i+size+2)
+		}
+	}
+	return vertices, indices
+}
+
+func TestBVH(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	vertices, indices := _BVHTestGrid(32, func(x, z int) float32 { return r.Float32() })
+	bvh := NewBVH(vertices, indices)
+	if bvh.TriangleCount() != 32*32*2 || !bvh.GetBounds().Contains(Vector3{16, 0.5, 16}) {
+		t.Error("BVH")
+	}
+
+	// compare with brute force
+	for i := 0; i < 100; i++ {
+		ray := NewRay(Vector3{r.Float32() * 32, 5, r.Float32() * 32}, Vector3{r.Float32() - 0.5, -1, r.Float32() - 0.5})
+		var expected RaycastHit
+		expectedTriangle := -1
+		for index := 0; index < bvh.TriangleCount(); index++ {
+			if hit, ok := bvh.Triangle(index).Raycast(ray); ok && (expectedTriangle < 0 || hit.Distance < expected.Distance) {
+				expected, expectedTriangle = hit, index
+			}
+		}
+		hit, ok := bvh.Raycast(ray, 100)
+		if ok != (expectedTriangle >= 0) || (ok && (hit.Triangle != expectedTriangle || hit.Distance != expected.Distance)) {
+			t.Error("BVH.Raycast")
+		}
+		if ok && (!bvh.Triangle(hit.Triangle).GetPoint(hit.U, hit.V).Equal(hit.Point) || hit.Normal.Y <= 0) {
+			t.Error("BVH.Raycast barycentric")
+		}
+		if _, anyOk := bvh.RaycastAny(ray, 100); anyOk != ok {
+			t.Error("BVH.RaycastAny")
+		}
+	}
+	if _, ok := bvh.Raycast(NewRay(Vector3{16, 5, 16}, V3Up()), 100); ok {
+		t.Error("BVH.Raycast miss")
+	}
+	if _, ok := bvh.RaycastAny(NewRay(Vector3{16, 5, 16}, V3Down()), 3); ok {
+		t.Error("BVH.RaycastAny maxDistance")
+	}
+
+	sphere := Sphere{Vector3{10.5, 0.5, 10.5}, 2}
+	capsule := Capsule{Vector3{2, 0.5, 2}, Vector3{8, 0.5, 2}, 0.5}
+	box := Bounds{Vector3{20.5, 0.5, 20.5}, Vector3{1, 2, 1}}
+	found := bvh.OverlapSphere(sphere, nil)
+	foundCapsule := bvh.OverlapCapsule(capsule, nil)
+	foundBox := bvh.OverlapBounds(box, nil)
+	var count, countCapsule, countBox int
+	for index := 0; index < bvh.TriangleCount(); index++ {
+		triangle := bvh.Triangle(index)
+		if OverlapSphereTriangle(sphere, triangle) {
+			count++
+		}
+		if OverlapCapsuleTriangle(capsule, triangle) {
+			countCapsule++
+		}
+		if OverlapBoundsTriangle(box, triangle) {
+			countBox++
+		}
+	}
+	if len(found) != count || count == 0 || len(foundCapsule) != countCapsule || countCapsule == 0 || len(foundBox) != countBox || countBox != 18 {
+		t.Error("BVH.Overlap")
+	}
+
+	// lift the mesh by 10
+	moved := make([]Vector3, len(vertices))
+	for i, v := range vertices {
+		moved[i] = v.Add(Vector3{0, 10, 0})
+	}
+	bvh.Refit(moved)
+	if hit, ok := bvh.Raycast(NewRay(Vector3{16.5, 20, 16.5}, V3Down()), 100); !ok || hit.Point.Y < 10 {
+		t.Error("BVH.Refit")
+	}
+	if len(bvh.OverlapSphere(sphere, nil)) != 0 {
+		t.Error("BVH.Refit overlap")
+	}
+}
@@ -0,0 +1,78 @@
+package gmath
+
+import "math"
+
+// Capsule the points within Radius of the segment from Start to End
+type Capsule struct {
+	Start  Vector3 `json:"start"`
+	End    Vector3 `json:"end"`
+	Radius float32 `json:"radius"`
+}
+
+func (capsule Capsule) Segment() Segment {
+	return Segment{capsule.Start, capsule.End}
+}
+
+func (capsule Capsule) Contains(point Vector3) bool {
+	segment := capsule.Segment()
+	closest := segment.GetPoint(_SegmentClosestT(segment, point))
+	return V3DistanceSqr(closest, point) <= capsule.Radius*capsule.Radius
+}
+
+func (capsule Capsule) GetBounds() Bounds {
+	r := Vector3{capsule.Radius, capsule.Radius, capsule.Radius}
+	return BoundsMinMax(V3Min(capsule.Start, capsule.End).Substract(r), r.Add(V3Max(capsule.Start, capsule.End)))
+}
+
+func (capsule Capsule) ClosestPoint(point Vector3) Vector3 {
+	segment := capsule.Segment()
+	return Sphere{segment.GetPoint(_SegmentClosestT(segment, point)), capsule.Radius}.ClosestPoint(point)
+}
+
+// Transform the radius is scaled by the max scale of the matrix
+func (capsule Capsule) Transform(matrix *Matrix4) Capsule {
+	return Capsule{matrix.MultiplyPoint3x4(capsule.Start), matrix.MultiplyPoint3x4(capsule.End), capsule.Radius * _Matrix4MaxScale(matrix)}
+}
+
+// Raycast hit at distance 0 if the origin is inside
+func (capsule Capsule) Raycast(ray Ray) (RaycastHit, bool) {
+	if capsule.Contains(ray.Origin) {
+		return _NewRaycastHit(ray, 0, ray.Direction.Scale(-1)), true
+	}
+
+	var distance float32 = math.MaxFloat32
+	if hit, ok := (Sphere{capsule.Start, capsule.Radius}).Raycast(ray); ok {
+		distance = hit.Distance
+	}
+	if hit, ok := (Sphere{capsule.End, capsule.Radius}).Raycast(ray); ok {
+		distance = F32Min(distance, hit.Distance)
+	}
+
+	// infinite cylinder, only the hit between the two caps counts
+	axis := capsule.End.Substract(capsule.Start)
+	offset := ray.Origin.Substract(capsule.Start)
+	sqrLength := axis.SqrMagnitude()
+	axisDir := axis.Dot(ray.Direction)
+	axisOffset := axis.Dot(offset)
+	a := sqrLength - axisDir*axisDir
+	if a > Epsilon*sqrLength {
+		b := sqrLength*ray.Direction.Dot(offset) - axisOffset*axisDir
+		c := sqrLength*offset.SqrMagnitude() - axisOffset*axisOffset - capsule.Radius*capsule.Radius*sqrLength
+		h := b*b - a*c
+		if h >= 0 {
+			t := (-b - F32Sqrt(h)) / a
+			y := axisOffset + t*axisDir
+			if t >= 0 && y > 0 && y < sqrLength {
+				distance = F32Min(distance, t)
+			}
+		}
+	}
+
+	if distance == math.MaxFloat32 {
+		return RaycastHit{}, false
+	}
+	hit := _NewRaycastHit(ray, distance, V3Zero())
+	segment := capsule.Segment()
+	hit.Normal = hit.Point.Substract(segment.GetPoint(_SegmentClosestT(segment, hit.Point))).Normalize()
+	return hit, true
+}
@@ -0,0 +1,30 @@
+package gmath
+
+// Capsule2D the points within Radius of the segment from Start to End
+type Capsule2D struct {
+	Start  Vector2 `json:"start"`
+	End    Vector2 `json:"end"`
+	Radius float32 `json:"radius"`
+}
+
+func (capsule Capsule2D) Contains(point Vector2) bool {
+	closest, _ := _V2SegmentClosestPoints(capsule.Start, capsule.End, point, point)
+	return V2DistanceSqr(closest, point) <= capsule.Radius*capsule.Radius
+}
+
+func (capsule Capsule2D) GetRect() Rect {
+	r := Vector2{capsule.Radius, capsule.Radius}
+	return RectMinMax(V2Min(capsule.Start, capsule.End).Substract(r), r.Add(V2Max(capsule.Start, capsule.End)))
+}
+
+func (capsule Capsule2D) Linecast(start Vector2, end Vector2) (RaycastHit2D, bool) {
+	return capsule._Hull()._Linecast(start, end)
+}
+
+func (capsule Capsule2D) _Convexes() []_Convex2D {
+	return []_Convex2D{capsule._Hull()}
+}
+
+func (capsule Capsule2D) _Hull() _Convex2D {
+	return _Convex2D{[]Vector2{capsule.Start, capsule.End}, capsule.Radius}
+}
@@ -0,0 +1,26 @@
+package gmath
+
+type Circle struct {
+	Center Vector2 `json:"center"`
+	Radius float32 `json:"radius"`
+}
+
+func (circle Circle) Contains(point Vector2) bool {
+	return V2DistanceSqr(circle.Center, point) <= circle.Radius*circle.Radius
+}
+
+func (circle Circle) GetRect() Rect {
+	return RectCenterSize(circle.Center, Vector2{circle.Radius * 2, circle.Radius * 2})
+}
+
+func (circle Circle) Linecast(start Vector2, end Vector2) (RaycastHit2D, bool) {
+	return circle._Hull()._Linecast(start, end)
+}
+
+func (circle Circle) _Convexes() []_Convex2D {
+	return []_Convex2D{circle._Hull()}
+}
+
+func (circle Circle) _Hull() _Convex2D {
+	return _Convex2D{[]Vector2{circle.Center}, circle.Radius}
+}
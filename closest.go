@@ -0,0 +1,138 @@
+package gmath
+
+// ClosestPointSegment t is [0,1] along the segment, 0 if the segment is degenerate
+func ClosestPointSegment(point Vector3, segment Segment) (distance float32, closest Vector3, t float32) {
+	t = _SegmentClosestT(segment, point)
+	closest = segment.GetPoint(t)
+	return V3Distance(point, closest), closest, t
+}
+
+// ClosestPointsSegmentSegment s and t are [0,1] along a and b, degenerate segments are treated as points
+func ClosestPointsSegmentSegment(a Segment, b Segment) (distance float32, closestA Vector3, closestB Vector3, s float32, t float32) {
+	s, t = _SegmentClosestParams(a, b)
+	closestA, closestB = a.GetPoint(s), b.GetPoint(t)
+	return V3Distance(closestA, closestB), closestA, closestB, s, t
+}
+
+// ClosestPointTriangle (u, v) is the barycentric of the closest point, see Triangle.GetPoint
+func ClosestPointTriangle(point Vector3, triangle Triangle) (distance float32, closest Vector3, u float32, v float32) {
+	u, v = _TriangleClosestParams(triangle, point)
+	closest = triangle.GetPoint(u, v)
+	return V3Distance(point, closest), closest, u, v
+}
+
+// ClosestPointBounds local is the closest point relative to the center, in [-Extents, Extents]
+func ClosestPointBounds(point Vector3, bounds Bounds) (distance float32, closest Vector3, local Vector3) {
+	closest = bounds.ClosestPoint(point)
+	return V3Distance(point, closest), closest, closest.Substract(bounds.Center)
+}
+
+// ClosestPointOBB local is the closest point in the space of the box, in [-Extents, Extents]
+func ClosestPointOBB(point Vector3, obb OBB) (distance float32, closest Vector3, local Vector3) {
+	local = obb._LocalBounds().ClosestPoint(obb.ToLocal(point))
+	closest = obb.ToWorld(local)
+	return V3Distance(point, closest), closest, local
+}
+
+// ClosestPointsSegmentTriangle t is [0,1] along the segment, (u, v) is the barycentric on the triangle
+func ClosestPointsSegmentTriangle(segment Segment, triangle Triangle) (distance float32, closestSegment Vector3, closestTriangle Vector3, t float32, u float32, v float32) {
+	// crossing the triangle
+	if dir := segment.Direction(); !dir.IsZero() {
+		length := dir.Magnitude()
+		hit, hitU, hitV, ok := triangle.RaycastBarycentric(Ray{segment.Start, dir.Scale(1 / length)})
+		if ok && hit.Distance <= length {
+			return 0, hit.Point, hit.Point, hit.Distance / length, hitU, hitV
+		}
+	}
+
+	var sqrDistance float32 = -1
+	try := func(tSegment, uTriangle, vTriangle float32) {
+		p1 := segment.GetPoint(tSegment)
+		p2 := triangle.GetPoint(uTriangle, vTriangle)
+		if d := V3DistanceSqr(p1, p2); sqrDistance < 0 || d < sqrDistance {
+			sqrDistance = d
+			closestSegment, closestTriangle = p1, p2
+			t, u, v = tSegment, uTriangle, vTriangle
+		}
+	}
+
+	// the end points against the face
+	u0, v0 := _TriangleClosestParams(triangle, segment.Start)
+	try(0, u0, v0)
+	u1, v1 := _TriangleClosestParams(triangle, segment.End)
+	try(1, u1, v1)
+
+	// the segment against the edges, AB is u in [0,1] with v = 0, AC is v with u = 0, BC is v with u = 1 - v
+	s, e := _SegmentClosestParams(segment, Segment{triangle.A, triangle.B})
+	try(s, e, 0)
+	s, e = _SegmentClosestParams(segment, Segment{triangle.A, triangle.C})
+	try(s, 0, e)
+	s, e = _SegmentClosestParams(segment, Segment{triangle.B, triangle.C})
+	try(s, 1-e, e)
+
+	return F32Sqrt(sqrDistance), closestSegment, closestTriangle, t, u, v
+}
+
+// _TriangleClosestParams from Real-Time Collision Detection 5.1.5, degenerate triangles use the closest edge
+func _TriangleClosestParams(triangle Triangle, point Vector3) (u float32, v float32) {
+	ab := triangle.B.Substract(triangle.A)
+	ac := triangle.C.Substract(triangle.A)
+	if ab.Cross(ac).SqrMagnitude() < Epsilon*Epsilon {
+		return _TriangleClosestEdgeParams(triangle, point)
+	}
+
+	ap := point.Substract(triangle.A)
+	d1 := ab.Dot(ap)
+	d2 := ac.Dot(ap)
+	if d1 <= 0 && d2 <= 0 {
+		return 0, 0
+	}
+
+	bp := point.Substract(triangle.B)
+	d3 := ab.Dot(bp)
+	d4 := ac.Dot(bp)
+	if d3 >= 0 && d4 <= d3 {
+		return 1, 0
+	}
+
+	vc := d1*d4 - d3*d2
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		return d1 / (d1 - d3), 0
+	}
+
+	cp := point.Substract(triangle.C)
+	d5 := ab.Dot(cp)
+	d6 := ac.Dot(cp)
+	if d6 >= 0 && d5 <= d6 {
+		return 0, 1
+	}
+
+	vb := d5*d2 - d1*d6
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		return 0, d2 / (d2 - d6)
+	}
+
+	va := d3*d6 - d5*d4
+	if va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		w := (d4 - d3) / ((d4 - d3) + (d5 - d6))
+		return 1 - w, w
+	}
+
+	denominator := 1 / (va + vb + vc)
+	return vb * denominator, vc * denominator
+}
+
+func _TriangleClosestEdgeParams(triangle Triangle, point Vector3) (u float32, v float32) {
+	tAB := _SegmentClosestT(Segment{triangle.A, triangle.B}, point)
+	tAC := _SegmentClosestT(Segment{triangle.A, triangle.C}, point)
+	tBC := _SegmentClosestT(Segment{triangle.B, triangle.C}, point)
+	dAB := V3DistanceSqr(point, V3LerpUnclamped(triangle.A, triangle.B, tAB))
+	dAC := V3DistanceSqr(point, V3LerpUnclamped(triangle.A, triangle.C, tAC))
+	dBC := V3DistanceSqr(point, V3LerpUnclamped(triangle.B, triangle.C, tBC))
+	if dAB <= dAC && dAB <= dBC {
+		return tAB, 0
+	} else if dAC <= dBC {
+		return 0, tAC
+	}
+	return 1 - tBC, tBC
+}
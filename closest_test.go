@@ -0,0 +1,54 @@
+package gmath
+
+import "testing"
+
+func TestClosest(t *testing.T) {
+	segment := Segment{Vector3{0, 0, 0}, Vector3{4, 0, 0}}
+	if d, p, s := ClosestPointSegment(Vector3{1, 2, 0}, segment); !F32Equal(d, 2) || !p.Equal(Vector3{1, 0, 0}) || !F32Equal(s, 0.25) {
+		t.Error("ClosestPointSegment")
+	}
+	if d, p, s := ClosestPointSegment(Vector3{1, 2, 1}, Segment{V3One(), V3One()}); !F32Equal(d, 1) || !p.Equal(V3One()) || s != 0 {
+		t.Error("ClosestPointSegment degenerate")
+	}
+
+	crossed := Segment{Vector3{2, 3, -1}, Vector3{2, 3, 3}}
+	if d, a, b, s, u := ClosestPointsSegmentSegment(segment, crossed); !F32Equal(d, 3) || !a.Equal(Vector3{2, 0, 0}) || !b.Equal(Vector3{2, 3, 0}) || !F32Equal(s, 0.5) || !F32Equal(u, 0.25) {
+		t.Error("ClosestPointsSegmentSegment")
+	}
+	parallel := Segment{Vector3{5, 1, 0}, Vector3{8, 1, 0}}
+	if d, a, b, _, _ := ClosestPointsSegmentSegment(segment, parallel); !F32Equal(d, F32Sqrt(2)) || !a.Equal(Vector3{4, 0, 0}) || !b.Equal(Vector3{5, 1, 0}) {
+		t.Error("ClosestPointsSegmentSegment parallel")
+	}
+	if d, _, b, _, u := ClosestPointsSegmentSegment(Segment{Vector3{2, 1, 0}, Vector3{2, 1, 0}}, segment); !F32Equal(d, 1) || !b.Equal(Vector3{2, 0, 0}) || !F32Equal(u, 0.5) {
+		t.Error("ClosestPointsSegmentSegment degenerate")
+	}
+
+	triangle := Triangle{Vector3{0, 0, 0}, Vector3{4, 0, 0}, Vector3{0, 0, 4}}
+	if d, p, u, v := ClosestPointTriangle(Vector3{1, 2, 1}, triangle); !F32Equal(d, 2) || !p.Equal(Vector3{1, 0, 1}) || !F32Equal(u, 0.25) || !F32Equal(v, 0.25) {
+		t.Error("ClosestPointTriangle face")
+	}
+	if d, p, u, v := ClosestPointTriangle(Vector3{4, 0, 4}, triangle); !F32Equal(d, 2*F32Sqrt(2)) || !p.Equal(Vector3{2, 0, 2}) || !F32Equal(u, 0.5) || !F32Equal(v, 0.5) {
+		t.Error("ClosestPointTriangle edge")
+	}
+	if _, p, u, v := ClosestPointTriangle(Vector3{-1, 0, -1}, triangle); !p.Equal(V3Zero()) || u != 0 || v != 0 {
+		t.Error("ClosestPointTriangle vertex")
+	}
+
+	obb := OBB{Vector3{10, 0, 0}, V3One(), QuaternionAngleAxis(AngleDegree(90).ToRadian(), V3Up())}
+	if d, p, local := ClosestPointOBB(Vector3{10, 0, 5}, obb); !F32Equal(d, 4) || !p.Equal(Vector3{10, 0, 1}) || !F32Equal(local.Magnitude(), 1) {
+		t.Error("ClosestPointOBB")
+	}
+	if d, p, local := ClosestPointBounds(Vector3{3, 0, 0}, Bounds{V3Zero(), V3One()}); !F32Equal(d, 2) || !p.Equal(V3Right()) || !local.Equal(V3Right()) {
+		t.Error("ClosestPointBounds")
+	}
+
+	if d, p, _, s, u, v := ClosestPointsSegmentTriangle(Segment{Vector3{1, 1, 1}, Vector3{1, -3, 1}}, triangle); d != 0 || !p.Equal(Vector3{1, 0, 1}) || !F32Equal(s, 0.25) || !F32Equal(u, 0.25) || !F32Equal(v, 0.25) {
+		t.Error("ClosestPointsSegmentTriangle crossing")
+	}
+	if d, a, b, _, _, _ := ClosestPointsSegmentTriangle(Segment{Vector3{3, 1, 3}, Vector3{3, 5, 3}}, triangle); !F32Equal(d, F32Sqrt(3)) || !a.Equal(Vector3{3, 1, 3}) || !b.Equal(Vector3{2, 0, 2}) {
+		t.Error("ClosestPointsSegmentTriangle end point")
+	}
+	if d, a, b, _, _, _ := ClosestPointsSegmentTriangle(Segment{Vector3{-1, 1, -2}, Vector3{-1, 1, 6}}, triangle); !F32Equal(d, F32Sqrt(2)) || !a.Equal(Vector3{-1, 1, a.Z}) || b.X != 0 || b.Y != 0 {
+		t.Error("ClosestPointsSegmentTriangle edge")
+	}
+}
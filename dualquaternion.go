@@ -0,0 +1,165 @@
+package gmath
+
+// DualQuaternion rigid transform, rotation then translation
+type DualQuaternion struct {
+	Real Quaternion `json:"real"`
+	Dual Quaternion `json:"dual"`
+}
+
+func DualQuaternionIdentity() DualQuaternion {
+	return DualQuaternion{QuaternionIdentity(), Quaternion{}}
+}
+
+// DualQuaternionFromTR rotate by rot, then translate by pos
+func DualQuaternionFromTR(pos Vector3, rot Quaternion) DualQuaternion {
+	rot.NormalizeSelf()
+	t := Quaternion{pos.X * 0.5, pos.Y * 0.5, pos.Z * 0.5, 0}
+	return DualQuaternion{rot, t.Multiply(rot)}
+}
+
+// DualQuaternionFromMatrix4 the scale of the matrix is ignored
+func DualQuaternionFromMatrix4(matrix *Matrix4) DualQuaternion {
+	return DualQuaternionFromTR(matrix.GetPosition(), matrix.GetRotation())
+}
+
+func (dq DualQuaternion) GetRotation() Quaternion {
+	return dq.Real
+}
+
+func (dq DualQuaternion) GetPosition() Vector3 {
+	t := dq.Dual.Multiply(dq.Real.Conjugate())
+	return Vector3{t.X * 2, t.Y * 2, t.Z * 2}
+}
+
+// ToTR return position and rotation
+func (dq DualQuaternion) ToTR() (Vector3, Quaternion) {
+	return dq.GetPosition(), dq.GetRotation()
+}
+
+func (dq DualQuaternion) ToMatrix4() Matrix4 {
+	return Matrix4TRS(dq.GetPosition(), dq.GetRotation(), V3One())
+}
+
+// Multiply left * right, apply right first
+func (left DualQuaternion) Multiply(right DualQuaternion) DualQuaternion {
+	d0 := left.Real.Multiply(right.Dual)
+	d1 := left.Dual.Multiply(right.Real)
+	return DualQuaternion{
+		Real: left.Real.Multiply(right.Real),
+		Dual: Quaternion{d0.X + d1.X, d0.Y + d1.Y, d0.Z + d1.Z, d0.W + d1.W},
+	}
+}
+
+// Conjugate the inverse of a unit dual quaternion
+func (dq DualQuaternion) Conjugate() DualQuaternion {
+	return DualQuaternion{dq.Real.Conjugate(), dq.Dual.Conjugate()}
+}
+
+func (dq DualQuaternion) Normalize() DualQuaternion {
+	dq.NormalizeSelf()
+	return dq
+}
+
+// NormalizeSelf unit real part, and dual part orthogonal to it, return false if real is zero
+func (dq *DualQuaternion) NormalizeSelf() bool {
+	n := dq.Real.Dot(dq.Real)
+	if n < _QuaternionEpsilon {
+		return false
+	}
+	inv := 1 / F32Sqrt(n)
+	dq.Real = Quaternion{dq.Real.X * inv, dq.Real.Y * inv, dq.Real.Z * inv, dq.Real.W * inv}
+	dq.Dual = Quaternion{dq.Dual.X * inv, dq.Dual.Y * inv, dq.Dual.Z * inv, dq.Dual.W * inv}
+
+	d := dq.Real.Dot(dq.Dual)
+	dq.Dual = Quaternion{
+		X: dq.Dual.X - dq.Real.X*d,
+		Y: dq.Dual.Y - dq.Real.Y*d,
+		Z: dq.Dual.Z - dq.Real.Z*d,
+		W: dq.Dual.W - dq.Real.W*d,
+	}
+	return true
+}
+
+func (dq DualQuaternion) TransformPoint(point Vector3) Vector3 {
+	position := dq.GetPosition()
+	return position.Add(dq.Real.MultiplyV3(point))
+}
+
+func (dq DualQuaternion) TransformDirection(dir Vector3) Vector3 {
+	return dq.Real.MultiplyV3(dir)
+}
+
+// Pow screw motion t times, dq must be normalized
+func (dq DualQuaternion) Pow(t float32) DualQuaternion {
+	if dq.Real.W < 0 {
+		dq = DualQuaternion{
+			Quaternion{-dq.Real.X, -dq.Real.Y, -dq.Real.Z, -dq.Real.W},
+			Quaternion{-dq.Dual.X, -dq.Dual.Y, -dq.Dual.Z, -dq.Dual.W},
+		}
+	}
+
+	axis := Vector3{dq.Real.X, dq.Real.Y, dq.Real.Z}
+	sinHalf := axis.Magnitude()
+	if F32IsZero2(sinHalf, 1e-6) {
+		// pure translation
+		return DualQuaternionFromTR(dq.GetPosition().Scale(t), QuaternionIdentity())
+	}
+
+	// screw parameters: angle, axis direction, pitch and moment
+	halfAngle := Atan2(sinHalf, dq.Real.W)
+	axis = axis.Scale(1 / sinHalf)
+	pitch := -2 * dq.Dual.W / sinHalf
+	moment := Vector3{dq.Dual.X, dq.Dual.Y, dq.Dual.Z}.
+		Substract(axis.Scale(pitch * 0.5 * dq.Real.W)).
+		Scale(1 / sinHalf)
+
+	halfAngle = halfAngle.Multiply(t)
+	pitch *= t
+	sinValue := Sin(halfAngle)
+	cosValue := Cos(halfAngle)
+
+	real := axis.Scale(sinValue)
+	dual := moment.Scale(sinValue)
+	dual.AddSelf(axis.Scale(pitch * 0.5 * cosValue))
+	return DualQuaternion{
+		Real: Quaternion{real.X, real.Y, real.Z, cosValue},
+		Dual: Quaternion{dual.X, dual.Y, dual.Z, -pitch * 0.5 * sinValue},
+	}
+}
+
+// DualQuaternionScLerp screw linear interpolation, constant speed on the shortest screw motion
+func DualQuaternionScLerp(from DualQuaternion, to DualQuaternion, t float32) DualQuaternion {
+	t = F32Clamp01(t)
+	if from.Real.Dot(to.Real) < 0 {
+		to = DualQuaternion{
+			Quaternion{-to.Real.X, -to.Real.Y, -to.Real.Z, -to.Real.W},
+			Quaternion{-to.Dual.X, -to.Dual.Y, -to.Dual.Z, -to.Dual.W},
+		}
+	}
+	diff := from.Conjugate().Multiply(to)
+	return from.Multiply(diff.Pow(t)).Normalize()
+}
+
+// DualQuaternionBlend dual quaternion linear blending (DLB), weights need not sum to 1
+// return identity if there is nothing to blend
+func DualQuaternionBlend(transforms []DualQuaternion, weights []float32) DualQuaternion {
+	ret := DualQuaternion{}
+	count := len(transforms)
+	if len(weights) < count {
+		count = len(weights)
+	}
+	for i := 0; i < count; i++ {
+		dq := transforms[i]
+		w := weights[i]
+		// the same hemisphere as the first one
+		if i > 0 && transforms[0].Real.Dot(dq.Real) < 0 {
+			w = -w
+		}
+		ret.Real = Quaternion{ret.Real.X + dq.Real.X*w, ret.Real.Y + dq.Real.Y*w, ret.Real.Z + dq.Real.Z*w, ret.Real.W + dq.Real.W*w}
+		ret.Dual = Quaternion{ret.Dual.X + dq.Dual.X*w, ret.Dual.Y + dq.Dual.Y*w, ret.Dual.Z + dq.Dual.Z*w, ret.Dual.W + dq.Dual.W*w}
+	}
+	if !ret.NormalizeSelf() {
+		return DualQuaternionIdentity()
+	}
+	return ret
+}
@@ -0,0 +1,70 @@
+package gmath
+
+import "testing"
+
+func TestDualQuaternion(t *testing.T) {
+	pos := Vector3{1, 2, 3}
+	rot := QuaternionFromEulerAngle(Vector3{10, 20, 30})
+	dq := DualQuaternionFromTR(pos, rot)
+	m := Matrix4TRS(pos, rot, V3One())
+	v := Vector3{4, 5, 6}
+
+	if !dq.TransformPoint(v).Equal(m.MultiplyPoint3x4(v)) || !dq.TransformDirection(v).Equal(m.MultiplyDir3(v)) {
+		t.Error("TransformPoint")
+	}
+	if !dq.GetPosition().Equal(pos) || !DualQuaternionFromMatrix4(&m).GetPosition().Equal(pos) {
+		t.Error("GetPosition")
+	}
+
+	dq2 := DualQuaternionFromTR(Vector3{-3, 0, 1}, QuaternionFromEulerAngle(Vector3{0, 90, 0}))
+	m2 := dq2.ToMatrix4()
+	m3 := m.Multiply(&m2)
+	if !dq.Multiply(dq2).TransformPoint(v).Equal(m3.MultiplyPoint3x4(v)) {
+		t.Error("Multiply")
+	}
+	if !dq.Multiply(dq.Conjugate()).TransformPoint(v).Equal(v) {
+		t.Error("Conjugate")
+	}
+
+	if !DualQuaternionScLerp(dq, dq2, 0).TransformPoint(v).Equal(dq.TransformPoint(v)) ||
+		!DualQuaternionScLerp(dq, dq2, 1).TransformPoint(v).Equal(dq2.TransformPoint(v)) {
+		t.Error("DualQuaternionScLerp")
+	}
+
+	// screw motion about Y: rotation and translation along the axis are both linear in t
+	screw := DualQuaternionFromTR(Vector3{0, 4, 0}, QuaternionAngleAxis(PI*2/3, V3Up()))
+	half := DualQuaternionScLerp(DualQuaternionIdentity(), screw, 0.5)
+	if !half.GetPosition().Equal(Vector3{0, 2, 0}) || QuaternionAngle(half.GetRotation(), QuaternionAngleAxis(PI/3, V3Up())) > 0.01 {
+		t.Error("DualQuaternionScLerp screw", half.GetPosition())
+	}
+
+	// rotate about the pivot, the screw axis does not pass the origin
+	pivot := Vector3{1, 0, 0}
+	r90 := QuaternionAngleAxis(PI/2, V3Up())
+	r45 := QuaternionAngleAxis(PI/4, V3Up())
+	half = DualQuaternionScLerp(DualQuaternionIdentity(), DualQuaternionFromTR(pivot.Substract(r90.MultiplyV3(pivot)), r90), 0.5)
+	if !half.GetPosition().Equal(pivot.Substract(r45.MultiplyV3(pivot))) {
+		t.Error("DualQuaternionScLerp pivot", half.GetPosition())
+	}
+
+	blend := DualQuaternionBlend([]DualQuaternion{dq, dq2}, []float32{0.5, 0.5})
+	if !F32Equal(blend.Real.Dot(blend.Real), 1) || !blend.GetPosition().IsValid() {
+		t.Error("DualQuaternionBlend")
+	}
+	// the same rotation, the translations are averaged
+	r30 := QuaternionAngleAxis(PI/6, V3Up())
+	blend = DualQuaternionBlend([]DualQuaternion{DualQuaternionFromTR(Vector3{2, 0, 0}, r30), DualQuaternionFromTR(Vector3{0, 0, 4}, r30)}, []float32{0.5, 0.5})
+	if position, rotation := blend.ToTR(); !position.Equal(Vector3{1, 0, 2}) || !rotation.Equal(r30) {
+		t.Error("DualQuaternionBlend translation")
+	}
+	// -45 and 45 degrees about the up axis, moved along it
+	rm45 := QuaternionAngleAxis(-PI/4, V3Up())
+	blend = DualQuaternionBlend([]DualQuaternion{DualQuaternionFromTR(Vector3{0, 2, 0}, rm45), DualQuaternionFromTR(Vector3{0, 2, 0}, r45)}, []float32{0.5, 0.5})
+	if position, rotation := blend.ToTR(); !position.Equal(Vector3{0, 2, 0}) || !rotation.Equal(QuaternionIdentity()) {
+		t.Error("DualQuaternionBlend rotation")
+	}
+	blend = DualQuaternionBlend([]DualQuaternion{dq, dq}, []float32{0.3, 0.7})
+	if !blend.TransformPoint(v).Equal(dq.TransformPoint(v)) {
+		t.Error("DualQuaternionBlend same")
+	}
+}
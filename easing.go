@@ -0,0 +1,247 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Ease Penner's easing functions, map t [0,1] to [0,1], Back and Elastic overshoot
+type Ease int
+
+const (
+	EaseLinear Ease = iota
+	EaseInQuad
+	EaseOutQuad
+	EaseInOutQuad
+	EaseInCubic
+	EaseOutCubic
+	EaseInOutCubic
+	EaseInQuart
+	EaseOutQuart
+	EaseInOutQuart
+	EaseInQuint
+	EaseOutQuint
+	EaseInOutQuint
+	EaseInSine
+	EaseOutSine
+	EaseInOutSine
+	EaseInExpo
+	EaseOutExpo
+	EaseInOutExpo
+	EaseInCirc
+	EaseOutCirc
+	EaseInOutCirc
+	EaseInBack
+	EaseOutBack
+	EaseInOutBack
+	EaseInElastic
+	EaseOutElastic
+	EaseInOutElastic
+	EaseInBounce
+	EaseOutBounce
+	EaseInOutBounce
+	EaseCount
+)
+
+var _EaseNames = [EaseCount]string{
+	"Linear",
+	"InQuad", "OutQuad", "InOutQuad",
+	"InCubic", "OutCubic", "InOutCubic",
+	"InQuart", "OutQuart", "InOutQuart",
+	"InQuint", "OutQuint", "InOutQuint",
+	"InSine", "OutSine", "InOutSine",
+	"InExpo", "OutExpo", "InOutExpo",
+	"InCirc", "OutCirc", "InOutCirc",
+	"InBack", "OutBack", "InOutBack",
+	"InElastic", "OutElastic", "InOutElastic",
+	"InBounce", "OutBounce", "InOutBounce",
+}
+
+// ParseEase case insensitive, "InOutQuad" => EaseInOutQuad
+func ParseEase(name string) (Ease, error) {
+	for i, v := range _EaseNames {
+		if strings.EqualFold(v, name) {
+			return Ease(i), nil
+		}
+	}
+	return EaseLinear, fmt.Errorf("gmath: unknown ease %q", name)
+}
+
+func (ease Ease) IsValid() bool {
+	return ease >= 0 && ease < EaseCount
+}
+
+func (ease Ease) String() string {
+	if !ease.IsValid() {
+		return fmt.Sprintf("Ease(%d)", int(ease))
+	}
+	return _EaseNames[ease]
+}
+
+func (ease Ease) MarshalText() ([]byte, error) {
+	if !ease.IsValid() {
+		return nil, fmt.Errorf("gmath: invalid ease %d", int(ease))
+	}
+	return []byte(_EaseNames[ease]), nil
+}
+
+func (ease *Ease) UnmarshalText(text []byte) error {
+	v, err := ParseEase(string(text))
+	if err != nil {
+		return err
+	}
+	*ease = v
+	return nil
+}
+
+// Evaluate t is clamped to [0,1], invalid ease is linear
+func (ease Ease) Evaluate(t float32) float32 {
+	x := float64(F32Clamp01(t))
+	switch ease {
+	case EaseInQuad:
+		return float32(x * x)
+	case EaseOutQuad:
+		return float32(1 - (1-x)*(1-x))
+	case EaseInOutQuad:
+		if x < 0.5 {
+			return float32(2 * x * x)
+		}
+		return float32(1 - math.Pow(-2*x+2, 2)/2)
+	case EaseInCubic:
+		return float32(x * x * x)
+	case EaseOutCubic:
+		return float32(1 - math.Pow(1-x, 3))
+	case EaseInOutCubic:
+		if x < 0.5 {
+			return float32(4 * x * x * x)
+		}
+		return float32(1 - math.Pow(-2*x+2, 3)/2)
+	case EaseInQuart:
+		return float32(x * x * x * x)
+	case EaseOutQuart:
+		return float32(1 - math.Pow(1-x, 4))
+	case EaseInOutQuart:
+		if x < 0.5 {
+			return float32(8 * x * x * x * x)
+		}
+		return float32(1 - math.Pow(-2*x+2, 4)/2)
+	case EaseInQuint:
+		return float32(x * x * x * x * x)
+	case EaseOutQuint:
+		return float32(1 - math.Pow(1-x, 5))
+	case EaseInOutQuint:
+		if x < 0.5 {
+			return float32(16 * x * x * x * x * x)
+		}
+		return float32(1 - math.Pow(-2*x+2, 5)/2)
+	case EaseInSine:
+		return float32(1 - math.Cos(x*math.Pi/2))
+	case EaseOutSine:
+		return float32(math.Sin(x * math.Pi / 2))
+	case EaseInOutSine:
+		return float32(-(math.Cos(math.Pi*x) - 1) / 2)
+	case EaseInExpo:
+		if x == 0 {
+			return 0
+		}
+		return float32(math.Pow(2, 10*x-10))
+	case EaseOutExpo:
+		if x == 1 {
+			return 1
+		}
+		return float32(1 - math.Pow(2, -10*x))
+	case EaseInOutExpo:
+		if x == 0 || x == 1 {
+			return float32(x)
+		}
+		if x < 0.5 {
+			return float32(math.Pow(2, 20*x-10) / 2)
+		}
+		return float32((2 - math.Pow(2, -20*x+10)) / 2)
+	case EaseInCirc:
+		return float32(1 - math.Sqrt(1-x*x))
+	case EaseOutCirc:
+		return float32(math.Sqrt(1 - (x-1)*(x-1)))
+	case EaseInOutCirc:
+		if x < 0.5 {
+			return float32((1 - math.Sqrt(1-4*x*x)) / 2)
+		}
+		return float32((math.Sqrt(1-math.Pow(-2*x+2, 2)) + 1) / 2)
+	case EaseInBack:
+		const c1 = 1.70158
+		return float32((c1+1)*x*x*x - c1*x*x)
+	case EaseOutBack:
+		const c1 = 1.70158
+		return float32(1 + (c1+1)*math.Pow(x-1, 3) + c1*math.Pow(x-1, 2))
+	case EaseInOutBack:
+		const c2 = 1.70158 * 1.525
+		if x < 0.5 {
+			return float32(math.Pow(2*x, 2) * ((c2+1)*2*x - c2) / 2)
+		}
+		return float32((math.Pow(2*x-2, 2)*((c2+1)*(2*x-2)+c2) + 2) / 2)
+	case EaseInElastic:
+		const c4 = 2 * math.Pi / 3
+		if x == 0 || x == 1 {
+			return float32(x)
+		}
+		return float32(-math.Pow(2, 10*x-10) * math.Sin((10*x-10.75)*c4))
+	case EaseOutElastic:
+		const c4 = 2 * math.Pi / 3
+		if x == 0 || x == 1 {
+			return float32(x)
+		}
+		return float32(math.Pow(2, -10*x)*math.Sin((10*x-0.75)*c4) + 1)
+	case EaseInOutElastic:
+		const c5 = 2 * math.Pi / 4.5
+		if x == 0 || x == 1 {
+			return float32(x)
+		}
+		if x < 0.5 {
+			return float32(-(math.Pow(2, 20*x-10) * math.Sin((20*x-11.125)*c5)) / 2)
+		}
+		return float32(math.Pow(2, -20*x+10)*math.Sin((20*x-11.125)*c5)/2 + 1)
+	case EaseInBounce:
+		return float32(1 - _EaseOutBounce(1-x))
+	case EaseOutBounce:
+		return float32(_EaseOutBounce(x))
+	case EaseInOutBounce:
+		if x < 0.5 {
+			return float32((1 - _EaseOutBounce(1-2*x)) / 2)
+		}
+		return float32((1 + _EaseOutBounce(2*x-1)) / 2)
+	default:
+		return float32(x)
+	}
+}
+
+func _EaseOutBounce(x float64) float64 {
+	const n1 = 7.5625
+	const d1 = 2.75
+	if x < 1/d1 {
+		return n1 * x * x
+	} else if x < 2/d1 {
+		x -= 1.5 / d1
+		return n1*x*x + 0.75
+	} else if x < 2.5/d1 {
+		x -= 2.25 / d1
+		return n1*x*x + 0.9375
+	} else {
+		x -= 2.625 / d1
+		return n1*x*x + 0.984375
+	}
+}
+
+//========================
+
+func F32LerpEase(from float32, to float32, t float32, ease Ease) float32 {
+	return F32LerpUnclamped(from, to, ease.Evaluate(t))
+}
+
+func V3LerpEase(from Vector3, to Vector3, t float32, ease Ease) Vector3 {
+	return V3LerpUnclamped(from, to, ease.Evaluate(t))
+}
+
+func QuaternionSlerpEase(from Quaternion, to Quaternion, t float32, ease Ease) Quaternion {
+	return QuaternionSlerpUnclamped(from, to, ease.Evaluate(t))
+}
@@ -0,0 +1,40 @@
+package gmath
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEase(t *testing.T) {
+	for ease := EaseLinear; ease < EaseCount; ease++ {
+		if !F32Equal(ease.Evaluate(0), 0) || !F32Equal(ease.Evaluate(1), 1) {
+			t.Error("Evaluate", ease)
+		}
+
+		v, err := ParseEase(ease.String())
+		if err != nil || v != ease {
+			t.Error("ParseEase", ease)
+		}
+	}
+
+	if !F32Equal(EaseInOutCubic.Evaluate(0.5), 0.5) || !F32Equal(EaseInQuad.Evaluate(0.5), 0.25) {
+		t.Error("Evaluate")
+	}
+	if EaseOutBack.Evaluate(0.5) <= 1 {
+		t.Error("EaseOutBack overshoot")
+	}
+
+	config := struct {
+		Ease Ease `json:"ease"`
+	}{}
+	if err := json.Unmarshal([]byte(`{"ease":"outbounce"}`), &config); err != nil || config.Ease != EaseOutBounce {
+		t.Error("UnmarshalText")
+	}
+	data, _ := json.Marshal(config)
+	if string(data) != `{"ease":"OutBounce"}` {
+		t.Error("MarshalText")
+	}
+	if _, err := ParseEase("InOutNothing"); err == nil {
+		t.Error("ParseEase")
+	}
+}
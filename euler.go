@@ -0,0 +1,233 @@
+package gmath
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EulerOrder ABC means q = qA * qB * qC, i.e. rotate about the local A, then local B, then local C.
+// EulerYXZ is the order of QuaternionFromEulerAngle, Unity calls it ZXY.
+// Blender's XYZ is EulerZYX here.
+type EulerOrder int
+
+const (
+	// Tait-Bryan
+	EulerXYZ EulerOrder = iota
+	EulerXZY
+	EulerYXZ
+	EulerYZX
+	EulerZXY
+	EulerZYX
+
+	// proper Euler
+	EulerXYX
+	EulerXZX
+	EulerYXY
+	EulerYZY
+	EulerZXZ
+	EulerZYZ
+
+	EulerOrderCount
+)
+
+var _EulerOrderNames = [EulerOrderCount]string{
+	"XYZ", "XZY", "YXZ", "YZX", "ZXY", "ZYX",
+	"XYX", "XZX", "YXY", "YZY", "ZXZ", "ZYZ",
+}
+
+// _EulerOrderAxes the axis index of the first, second and third rotation
+var _EulerOrderAxes = [EulerOrderCount][3]int{
+	{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0},
+	{0, 1, 0}, {0, 2, 0}, {1, 0, 1}, {1, 2, 1}, {2, 0, 2}, {2, 1, 2},
+}
+
+// ParseEulerOrder case insensitive, "yxz" => EulerYXZ
+func ParseEulerOrder(name string) (EulerOrder, error) {
+	for i, v := range _EulerOrderNames {
+		if strings.EqualFold(v, name) {
+			return EulerOrder(i), nil
+		}
+	}
+	return EulerXYZ, fmt.Errorf("gmath: unknown euler order %q", name)
+}
+
+func (order EulerOrder) IsValid() bool {
+	return order >= 0 && order < EulerOrderCount
+}
+
+// IsTaitBryan three different axes
+func (order EulerOrder) IsTaitBryan() bool {
+	return order >= EulerXYZ && order <= EulerZYX
+}
+
+func (order EulerOrder) String() string {
+	if !order.IsValid() {
+		return fmt.Sprintf("EulerOrder(%d)", int(order))
+	}
+	return _EulerOrderNames[order]
+}
+
+func (order EulerOrder) MarshalText() ([]byte, error) {
+	if !order.IsValid() {
+		return nil, fmt.Errorf("gmath: invalid euler order %d", int(order))
+	}
+	return []byte(_EulerOrderNames[order]), nil
+}
+
+func (order *EulerOrder) UnmarshalText(text []byte) error {
+	v, err := ParseEulerOrder(string(text))
+	if err != nil {
+		return err
+	}
+	*order = v
+	return nil
+}
+
+// AngleUnit of EulerAngles
+type AngleUnit int
+
+const (
+	AngleUnitDegree AngleUnit = iota
+	AngleUnitRadian
+)
+
+func (unit AngleUnit) String() string {
+	if unit == AngleUnitRadian {
+		return "radian"
+	}
+	return "degree"
+}
+
+func (unit AngleUnit) MarshalText() ([]byte, error) {
+	return []byte(unit.String()), nil
+}
+
+func (unit *AngleUnit) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "degree", "deg":
+		*unit = AngleUnitDegree
+	case "radian", "rad":
+		*unit = AngleUnitRadian
+	default:
+		return fmt.Errorf("gmath: unknown angle unit %q", string(text))
+	}
+	return nil
+}
+
+// EulerAngles Angles are the first, second and third rotation of Order, in Unit
+type EulerAngles struct {
+	Order  EulerOrder `json:"order"`
+	Unit   AngleUnit  `json:"unit"`
+	Angles [3]float32 `json:"angles"`
+}
+
+func EulerAnglesDegree(order EulerOrder, first, second, third AngleDegree) EulerAngles {
+	return EulerAngles{order, AngleUnitDegree, [3]float32{first.ToFloat32(), second.ToFloat32(), third.ToFloat32()}}
+}
+
+func EulerAnglesRadian(order EulerOrder, first, second, third AngleRadian) EulerAngles {
+	return EulerAngles{order, AngleUnitRadian, [3]float32{first.ToFloat32(), second.ToFloat32(), third.ToFloat32()}}
+}
+
+// EulerAnglesFromQuaternion Tait-Bryan: first and third [-PI,PI], second [-PI/2,PI/2];
+// proper Euler: first and third [-PI,PI], second [0,PI].
+// In gimbal lock the third angle is 0
+func EulerAnglesFromQuaternion(q Quaternion, order EulerOrder, unit AngleUnit) EulerAngles {
+	// how close to the lock the second angle is snapped to it, only the sum of the first and the third matters there
+	const gimbalThreshold = 1e-4
+
+	// Bernardes and Viollet, the half sum and the half difference of the first and the third angles
+	// are read from the quaternion, so the float rounding is not amplified near the lock.
+	// It is written for the extrinsic rotations, i.e. the axes reversed: i is the third rotation and k the first
+	axes := _EulerOrderAxes[order]
+	i, j, k := axes[2], axes[1], axes[0]
+	properEuler := i == k
+	if properEuler {
+		k = 3 - i - j
+	}
+	sign := float32((i - j) * (j - k) * (k - i) / 2)
+
+	v := [3]float32{q.X, q.Y, q.Z}
+	var a, b, c, d float32
+	if properEuler {
+		a, b, c, d = q.W, v[i], v[j], v[k]*sign
+	} else {
+		a, b, c, d = q.W-v[j], v[i]+v[k]*sign, v[j]+q.W, v[k]*sign-v[i]
+	}
+	second := Atan2(F32Sqrt(c*c+d*d), F32Sqrt(a*a+b*b)) * 2
+	halfSum := Atan2(b, a)
+	halfDiff := Atan2(d, c)
+
+	var first, third AngleRadian
+	if second <= gimbalThreshold {
+		second = 0
+		first = halfSum * 2
+	} else if second >= PI-gimbalThreshold {
+		second = PI
+		first = halfDiff * 2
+	} else {
+		first = halfSum + halfDiff
+		third = halfSum - halfDiff
+	}
+	if !properEuler {
+		first *= AngleRadian(sign)
+		second -= PI / 2
+	}
+
+	ret := EulerAnglesRadian(order, first.NormalizeHalf(), second, third.NormalizeHalf())
+	if unit == AngleUnitDegree {
+		ret = ret.ToDegree()
+	}
+	return ret
+}
+
+// EulerAnglesFromMatrix4 the scale of the matrix is ignored
+func EulerAnglesFromMatrix4(matrix *Matrix4, order EulerOrder, unit AngleUnit) EulerAngles {
+	return EulerAnglesFromQuaternion(matrix.GetRotation(), order, unit)
+}
+
+func (euler EulerAngles) Radian(index int) AngleRadian {
+	if euler.Unit == AngleUnitDegree {
+		return AngleDegree(euler.Angles[index]).ToRadian()
+	}
+	return AngleRadian(euler.Angles[index])
+}
+
+func (euler EulerAngles) Degree(index int) AngleDegree {
+	if euler.Unit == AngleUnitRadian {
+		return AngleRadian(euler.Angles[index]).ToDegrees()
+	}
+	return AngleDegree(euler.Angles[index])
+}
+
+func (euler EulerAngles) ToRadian() EulerAngles {
+	return EulerAnglesRadian(euler.Order, euler.Radian(0), euler.Radian(1), euler.Radian(2))
+}
+
+func (euler EulerAngles) ToDegree() EulerAngles {
+	return EulerAnglesDegree(euler.Order, euler.Degree(0), euler.Degree(1), euler.Degree(2))
+}
+
+func (euler EulerAngles) ToQuaternion() Quaternion {
+	axes := _EulerOrderAxes[euler.Order]
+	ret := QuaternionIdentity()
+	for index, axis := range axes {
+		ret = ret.Multiply(QuaternionAngleAxis(euler.Radian(index), _EulerAxis(axis)))
+	}
+	return ret
+}
+
+func (euler EulerAngles) ToMatrix4() Matrix4 {
+	return Matrix4FromRotation(euler.ToQuaternion())
+}
+
+func _EulerAxis(axis int) Vector3 {
+	switch axis {
+	case 0:
+		return V3Right()
+	case 1:
+		return V3Up()
+	default:
+		return V3Forward()
+	}
+}
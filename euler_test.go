@@ -0,0 +1,60 @@
+package gmath
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEulerAngles(t *testing.T) {
+	euler := EulerAnglesDegree(EulerYXZ, 20, 10, 30)
+	if !euler.ToQuaternion().Equal(QuaternionFromEulerAngle(Vector3{10, 20, 30})) {
+		t.Error("EulerYXZ")
+	}
+
+	for order := EulerXYZ; order < EulerOrderCount; order++ {
+		for _, angles := range [][3]AngleDegree{
+			{10, 20, 30},
+			{-100, 70, 160},
+			{45, 90, 10},
+			{45, -90, 10},
+			{30, 0, 60},
+			{30, 180, 60},
+			{30, 89.3, 40},
+			{30, 89.5, 40},
+			{30, -89.7, 40},
+			{30, 89.99, 40},
+			{30, 89.999, 77},
+			{-120, 89.9999, 77},
+			{30, -89.999, -150},
+			{30, -89.9999, 77},
+			{30, 0.001, 40},
+			{30, 179.999, -40},
+			{30, 0.5, 40},
+			{30, 179.5, 40},
+		} {
+			euler := EulerAnglesDegree(order, angles[0], angles[1], angles[2])
+			q := euler.ToQuaternion()
+			euler2 := EulerAnglesFromQuaternion(q, order, AngleUnitRadian)
+			if QuaternionAngle(q, euler2.ToQuaternion()) > 0.01 {
+				t.Error("EulerAnglesFromQuaternion", order, angles, euler2.ToDegree().Angles)
+			}
+
+			m := euler.ToMatrix4()
+			euler3 := EulerAnglesFromMatrix4(&m, order, AngleUnitDegree)
+			if QuaternionAngle(q, euler3.ToQuaternion()) > 0.01 {
+				t.Error("EulerAnglesFromMatrix4", order, angles, euler3.Angles)
+			}
+		}
+	}
+
+	euler = EulerAnglesFromQuaternion(EulerAnglesDegree(EulerZYX, 10, 20, 30).ToQuaternion(), EulerZYX, AngleUnitDegree)
+	if !F32Equal2(euler.Angles[0], 10, 1e-3) || !F32Equal2(euler.Angles[1], 20, 1e-3) || !F32Equal2(euler.Angles[2], 30, 1e-3) {
+		t.Error("EulerAnglesFromQuaternion", euler.Angles)
+	}
+
+	data, _ := json.Marshal(euler)
+	euler2 := EulerAngles{}
+	if err := json.Unmarshal(data, &euler2); err != nil || euler2.Order != EulerZYX || euler2.Unit != AngleUnitDegree {
+		t.Error("json", string(data))
+	}
+}
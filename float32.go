@@ -25,6 +25,20 @@ func F32Ceil(v float32) float32 {
 	return float32(math.Ceil(float64(v)))
 }
 
+func F32CeilToInt(v float32) int {
+	return int(math.Ceil(float64(v)))
+}
+
+// F32Round half away from zero
+func F32Round(v float32) float32 {
+	return float32(math.Round(float64(v)))
+}
+
+// F32RoundToInt half away from zero
+func F32RoundToInt(v float32) int {
+	return int(math.Round(float64(v)))
+}
+
 func F32IsNaN(v float32) bool {
 	return math.IsNaN(float64(v))
 }
@@ -107,3 +121,43 @@ func F32LerpUnclamped(from float32, to float32, t float32) float32 {
 func F32Lerp(from float32, to float32, t float32) float32 {
 	return (to-from)*F32Clamp01(t) + from
 }
+
+// F32SmoothDamp critically damped spring, currentVelocity is updated in place
+func F32SmoothDamp(current float32, target float32, currentVelocity *float32, smoothTime float32, maxSpeed float32, deltaTime float32) float32 {
+	if deltaTime <= 0 {
+		return current
+	}
+	smoothTime = F32Max(0.0001, smoothTime)
+	omega := 2 / smoothTime
+	x := omega * deltaTime
+	exp := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	originalTo := target
+	maxChange := maxSpeed * smoothTime
+	change := F32Clamp(current-target, -maxChange, maxChange)
+	target = current - change
+
+	temp := (*currentVelocity + omega*change) * deltaTime
+	*currentVelocity = (*currentVelocity - omega*temp) * exp
+	ret := target + (change+temp)*exp
+
+	// prevent overshooting
+	if (originalTo-current > 0) == (ret > originalTo) {
+		ret = originalTo
+		*currentVelocity = 0
+	}
+	return ret
+}
+
+// F32DampFactor the lerp t which moves half of the remaining distance every halfLife, independent of the frame rate
+func F32DampFactor(halfLife float32, deltaTime float32) float32 {
+	if halfLife <= 0 {
+		return 1
+	}
+	return 1 - float32(math.Exp2(float64(-deltaTime/halfLife)))
+}
+
+// F32Damp exponential decay towards target
+func F32Damp(current float32, target float32, halfLife float32, deltaTime float32) float32 {
+	return F32LerpUnclamped(current, target, F32DampFactor(halfLife, deltaTime))
+}
@@ -0,0 +1,102 @@
+package gmath
+
+// Containment the result of testing a shape against a volume
+type Containment int
+
+const (
+	ContainmentOutside Containment = iota
+	ContainmentIntersect
+	ContainmentInside
+)
+
+// indices of Frustum.Planes, same order as Unity's GeometryUtility.CalculateFrustumPlanes
+const (
+	FrustumLeft = iota
+	FrustumRight
+	FrustumBottom
+	FrustumTop
+	FrustumNear
+	FrustumFar
+)
+
+// Frustum the normals of the planes point inside
+type Frustum struct {
+	Planes [6]Plane `json:"planes"`
+}
+
+// FrustumFromMatrix projection * view, or projection * view * model for the model space, Unity's clip space
+func FrustumFromMatrix(viewProjection *Matrix4) Frustum {
+	return FrustumFromMatrixClip(viewProjection, DepthNegativeOneToOne)
+}
+
+// FrustumFromMatrixClip Gribb-Hartmann, depth is the depth range of the projection
+func FrustumFromMatrixClip(viewProjection *Matrix4, depth DepthRange) Frustum {
+	row0 := viewProjection.GetRow(0)
+	row1 := viewProjection.GetRow(1)
+	row2 := viewProjection.GetRow(2)
+	row3 := viewProjection.GetRow(3)
+
+	near := row3.Add(row2)
+	if depth == DepthZeroToOne {
+		near = row2
+	}
+
+	frustum := Frustum{}
+	frustum.Planes[FrustumLeft] = _PlaneFromV4(row3.Add(row0))
+	frustum.Planes[FrustumRight] = _PlaneFromV4(row3.Substract(row0))
+	frustum.Planes[FrustumBottom] = _PlaneFromV4(row3.Add(row1))
+	frustum.Planes[FrustumTop] = _PlaneFromV4(row3.Substract(row1))
+	frustum.Planes[FrustumNear] = _PlaneFromV4(near)
+	frustum.Planes[FrustumFar] = _PlaneFromV4(row3.Substract(row2))
+	return frustum
+}
+
+func (frustum Frustum) ContainsPoint(point Vector3) bool {
+	return frustum.ClassifyPoint(point) != ContainmentOutside
+}
+
+func (frustum Frustum) ClassifyPoint(point Vector3) Containment {
+	for _, plane := range frustum.Planes {
+		if plane.GetDistanceToPoint(point) < 0 {
+			return ContainmentOutside
+		}
+	}
+	return ContainmentInside
+}
+
+// ClassifySphere conservative, a sphere near the corners may be Intersect while it is outside
+func (frustum Frustum) ClassifySphere(sphere Sphere) Containment {
+	return frustum._Classify(func(plane Plane) PlaneSide { return plane.ClassifySphere(sphere) })
+}
+
+// ClassifyBounds conservative, same as ClassifySphere
+func (frustum Frustum) ClassifyBounds(bounds Bounds) Containment {
+	return frustum._Classify(func(plane Plane) PlaneSide { return plane.ClassifyBounds(bounds) })
+}
+
+// ClassifyOBB conservative, same as ClassifySphere
+func (frustum Frustum) ClassifyOBB(obb OBB) Containment {
+	return frustum._Classify(func(plane Plane) PlaneSide { return plane.ClassifyOBB(obb) })
+}
+
+func (frustum Frustum) _Classify(classify func(plane Plane) PlaneSide) Containment {
+	result := ContainmentInside
+	for _, plane := range frustum.Planes {
+		switch classify(plane) {
+		case PlaneSideBack:
+			return ContainmentOutside
+		case PlaneSideIntersect:
+			result = ContainmentIntersect
+		}
+	}
+	return result
+}
+
+// _PlaneFromV4 ax + by + cz + d = 0, normalized
+func _PlaneFromV4(v Vector4) Plane {
+	length := Vector3{v.X, v.Y, v.Z}.Magnitude()
+	if length == 0 {
+		return Plane{}
+	}
+	return Plane{Vector3{v.X / length, v.Y / length, v.Z / length}, v.W / length}
+}
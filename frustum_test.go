@@ -0,0 +1,37 @@
+package gmath
+
+import "testing"
+
+func TestFrustum(t *testing.T) {
+	eye := Vector3{0, 1, 0}
+	for _, clip := range []struct {
+		handedness Handedness
+		depth      DepthRange
+	}{{RightHanded, DepthNegativeOneToOne}, {LeftHanded, DepthZeroToOne}} {
+		view := Matrix4LookAtView(eye, Vector3{0, 1, 10}, V3Up(), clip.handedness)
+		projection := Matrix4PerspectiveClip(90, 1, 0.1, 100, clip.handedness, clip.depth)
+		viewProjection := projection.Multiply(&view)
+		frustum := FrustumFromMatrixClip(&viewProjection, clip.depth)
+
+		if !frustum.ContainsPoint(Vector3{0, 1, 10}) || frustum.ContainsPoint(Vector3{0, 1, -10}) || frustum.ContainsPoint(Vector3{0, 1, 0.05}) ||
+			!frustum.ContainsPoint(Vector3{9.9, 1, 10}) || frustum.ContainsPoint(Vector3{10.1, 1, 10}) || frustum.ContainsPoint(Vector3{0, 1, 101}) {
+			t.Error("Frustum.ContainsPoint")
+		}
+		if !F32Equal(frustum.Planes[FrustumNear].GetDistanceToPoint(eye), -0.1) || !frustum.Planes[FrustumLeft].GetSide(Vector3{0, 1, 1}) {
+			t.Error("Frustum.Planes")
+		}
+		if frustum.ClassifySphere(Sphere{Vector3{0, 1, 10}, 1}) != ContainmentInside ||
+			frustum.ClassifySphere(Sphere{Vector3{10, 1, 10}, 1}) != ContainmentIntersect ||
+			frustum.ClassifySphere(Sphere{Vector3{0, 1, -10}, 1}) != ContainmentOutside {
+			t.Error("Frustum.ClassifySphere")
+		}
+		if frustum.ClassifyBounds(Bounds{Vector3{0, 1, 100}, V3One()}) != ContainmentIntersect ||
+			frustum.ClassifyBounds(Bounds{Vector3{0, 30, 10}, V3One()}) != ContainmentOutside {
+			t.Error("Frustum.ClassifyBounds")
+		}
+		obb := OBB{Vector3{0, 1, 50}, Vector3{1, 1, 1}, QuaternionAngleAxis(AngleDegree(45).ToRadian(), V3Up())}
+		if frustum.ClassifyOBB(obb) != ContainmentInside {
+			t.Error("Frustum.ClassifyOBB")
+		}
+	}
+}
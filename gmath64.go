@@ -0,0 +1,6 @@
+package gmath
+
+// gmath64 is the float64 version of gmath, for large worlds where float32 loses precision.
+// Conversions between the two precisions are in gmath64/convert.go
+
+//go:generate go run ./internal/gen64 -o gmath64 float32.go angle.go vector2.go vector3.go vector4.go quaternion.go dualquaternion.go euler.go matrix3.go matrix4.go matrix4_encoding.go projection.go
@@ -0,0 +1,81 @@
+package gmath64
+
+import "github.com/fancyhub/gmath"
+
+func AngleDegreeFromF32(angle gmath.AngleDegree) AngleDegree {
+	return AngleDegree(angle)
+}
+
+func (angle AngleDegree) ToF32() gmath.AngleDegree {
+	return gmath.AngleDegree(angle)
+}
+
+func AngleRadianFromF32(angle gmath.AngleRadian) AngleRadian {
+	return AngleRadian(angle)
+}
+
+func (angle AngleRadian) ToF32() gmath.AngleRadian {
+	return gmath.AngleRadian(angle)
+}
+
+func V2FromF32(v gmath.Vector2) Vector2 {
+	return Vector2{float64(v.X), float64(v.Y)}
+}
+
+func (v2 Vector2) ToF32() gmath.Vector2 {
+	return gmath.Vector2{X: float32(v2.X), Y: float32(v2.Y)}
+}
+
+func V3FromF32(v gmath.Vector3) Vector3 {
+	return Vector3{float64(v.X), float64(v.Y), float64(v.Z)}
+}
+
+func (v3 Vector3) ToF32() gmath.Vector3 {
+	return gmath.Vector3{X: float32(v3.X), Y: float32(v3.Y), Z: float32(v3.Z)}
+}
+
+func V4FromF32(v gmath.Vector4) Vector4 {
+	return Vector4{float64(v.X), float64(v.Y), float64(v.Z), float64(v.W)}
+}
+
+func (v4 Vector4) ToF32() gmath.Vector4 {
+	return gmath.Vector4{X: float32(v4.X), Y: float32(v4.Y), Z: float32(v4.Z), W: float32(v4.W)}
+}
+
+func QuaternionFromF32(q gmath.Quaternion) Quaternion {
+	return Quaternion{float64(q.X), float64(q.Y), float64(q.Z), float64(q.W)}
+}
+
+func (q Quaternion) ToF32() gmath.Quaternion {
+	return gmath.Quaternion{X: float32(q.X), Y: float32(q.Y), Z: float32(q.Z), W: float32(q.W)}
+}
+
+func Matrix4FromF32(m gmath.Matrix4) Matrix4 {
+	return Matrix4FromColumns(
+		V4FromF32(m.GetColumn(0)),
+		V4FromF32(m.GetColumn(1)),
+		V4FromF32(m.GetColumn(2)),
+		V4FromF32(m.GetColumn(3)))
+}
+
+func (matrix *Matrix4) ToF32() gmath.Matrix4 {
+	return gmath.Matrix4FromColumns(
+		matrix.GetColumn(0).ToF32(),
+		matrix.GetColumn(1).ToF32(),
+		matrix.GetColumn(2).ToF32(),
+		matrix.GetColumn(3).ToF32())
+}
+
+func Matrix3FromF32(m gmath.Matrix3) Matrix3 {
+	return Matrix3FromColumns(
+		V3FromF32(m.GetColumn(0)),
+		V3FromF32(m.GetColumn(1)),
+		V3FromF32(m.GetColumn(2)))
+}
+
+func (matrix *Matrix3) ToF32() gmath.Matrix3 {
+	return gmath.Matrix3FromColumns(
+		matrix.GetColumn(0).ToF32(),
+		matrix.GetColumn(1).ToF32(),
+		matrix.GetColumn(2).ToF32())
+}
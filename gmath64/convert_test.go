@@ -0,0 +1,42 @@
+package gmath64
+
+import (
+	"testing"
+
+	"github.com/fancyhub/gmath"
+)
+
+func TestConvert(t *testing.T) {
+	v1 := gmath.Vector3{X: 100000.1, Y: 0.3, Z: -7}
+	if V3FromF32(v1).ToF32() != v1 {
+		t.Error("V3FromF32")
+	}
+
+	q1 := gmath.QuaternionFromEulerAngle(gmath.Vector3{X: 10, Y: 20, Z: 30})
+	if QuaternionFromF32(q1).ToF32() != q1 {
+		t.Error("QuaternionFromF32")
+	}
+
+	m1 := gmath.Matrix4TRS(v1, q1, gmath.Vector3{X: 1, Y: 2, Z: 3})
+	m2 := Matrix4FromF32(m1)
+	if m2.ToF32() != m1 {
+		t.Error("Matrix4FromF32")
+	}
+
+	// the float64 version keeps the precision the float32 version loses
+	pos := Vector3{123456.789, 0, 987654.321}
+	offset := Vector3{0.001, 0, 0}
+	if !F64Equal2(V3Distance(pos, pos.Substract(offset)), 0.001, 1e-9) {
+		t.Error("V3Distance")
+	}
+
+	m3 := Matrix4TRS(pos, QuaternionFromEulerAngle(Vector3{10, 20, 30}), V3One())
+	m4 := m3.Inverse()
+	if !m4.MultiplyPoint3x4(pos.Substract(offset)).Equal(m4.MultiplyDir3(offset).Scale(-1)) {
+		t.Error("Matrix4.Inverse")
+	}
+
+	if AngleDegree(0.1).ToFloat64() != 0.1 || AngleRadian(PI).ToFloat64() != PI {
+		t.Error("ToFloat64")
+	}
+}
@@ -0,0 +1,4 @@
+// Package gmath64 is the float64 version of gmath, with the same API.
+//
+// All files except doc.go and convert.go are generated from gmath by go generate.
+package gmath64
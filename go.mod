@@ -1,3 +1,3 @@
 module github.com/fancyhub/gmath
 
-go 1.16.0
+go 1.16
@@ -0,0 +1,90 @@
+// gen64 generates the float64 version of gmath.
+//
+// It copies the given source files into the output package, replacing
+// float32 with float64 and the F32 prefix with F64, so that both packages
+// share the same API.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var _Renames = map[string]string{
+	"float32":                "float64",
+	"Float32bits":            "Float64bits",
+	"Float32frombits":        "Float64frombits",
+	"MaxFloat32":             "MaxFloat64",
+	"SmallestNonzeroFloat32": "SmallestNonzeroFloat64",
+	"ToFloat32":              "ToFloat64",
+}
+
+func rename(name string) string {
+	if v, ok := _Renames[name]; ok {
+		return v
+	}
+	if strings.HasPrefix(name, "F32") {
+		return "F64" + name[3:]
+	}
+	return name
+}
+
+func renameText(text string) string {
+	text = strings.ReplaceAll(text, "float32", "float64")
+	text = strings.ReplaceAll(text, "F32", "F64")
+	text = strings.ReplaceAll(text, "ToFloat32", "ToFloat64")
+	return text
+}
+
+func generate(srcFile string, outDir string) error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, srcFile, nil, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	file.Name.Name = filepath.Base(outDir)
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			n.Name = rename(n.Name)
+		case *ast.Comment:
+			n.Text = renameText(n.Text)
+		}
+		return true
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen64 from %s; DO NOT EDIT.\n\n", filepath.Base(srcFile))
+	if err := format.Node(&buf, fset, file); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	src = bytes.ReplaceAll(src, []byte("\n"), []byte("\r\n"))
+
+	outFile := filepath.Join(outDir, strings.ReplaceAll(filepath.Base(srcFile), "float32", "float64"))
+	return os.WriteFile(outFile, src, 0644)
+}
+
+func main() {
+	outDir := flag.String("o", "gmath64", "output package directory")
+	flag.Parse()
+
+	for _, srcFile := range flag.Args() {
+		if err := generate(srcFile, *outDir); err != nil {
+			log.Fatalf("gen64: %s: %v", srcFile, err)
+		}
+	}
+}
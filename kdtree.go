@@ -0,0 +1,387 @@
+package gmath
+
+import "sort"
+
+// KDTreeNeighbor Index is the index of the point in the slice the tree is built from
+type KDTreeNeighbor struct {
+	Index    int
+	Distance float32
+}
+
+// KDTree2 static balanced kd-tree of Vector2 points
+type KDTree2 struct {
+	tree _KDTree
+}
+
+// KDTree3 static balanced kd-tree of Vector3 points
+type KDTree3 struct {
+	tree _KDTree
+}
+
+// NewKDTree2 the points are copied, O(n log n) expected and O(n log^2 n) at worst
+func NewKDTree2(points []Vector2) *KDTree2 {
+	copied := make([]Vector3, len(points))
+	for i, p := range points {
+		copied[i] = Vector3{p.X, p.Y, 0}
+	}
+	return &KDTree2{_NewKDTree(copied, 2)}
+}
+
+// NewKDTree3 the points are copied, O(n log n) expected and O(n log^2 n) at worst
+func NewKDTree3(points []Vector3) *KDTree3 {
+	return &KDTree3{_NewKDTree(append([]Vector3(nil), points...), 3)}
+}
+
+func (tree *KDTree2) Count() int {
+	return len(tree.tree.points)
+}
+
+// Nearest false if no point is within maxDistance, use math.MaxFloat32 for no limit
+func (tree *KDTree2) Nearest(point Vector2, maxDistance float32) (KDTreeNeighbor, bool) {
+	return tree.tree._Nearest(Vector3{point.X, point.Y, 0}, maxDistance, nil)
+}
+
+// NearestFunc the nearest point whose index satisfies predicate
+func (tree *KDTree2) NearestFunc(point Vector2, maxDistance float32, predicate func(index int) bool) (KDTreeNeighbor, bool) {
+	return tree.tree._Nearest(Vector3{point.X, point.Y, 0}, maxDistance, predicate)
+}
+
+// KNearest appends at most k neighbors within maxDistance to result, ordered by distance,
+// it does not allocate if result has enough capacity
+func (tree *KDTree2) KNearest(point Vector2, k int, maxDistance float32, result []KDTreeNeighbor) []KDTreeNeighbor {
+	return tree.tree._KNearest(Vector3{point.X, point.Y, 0}, k, maxDistance, result)
+}
+
+// QueryRadius appends the neighbors within radius to result, not ordered
+func (tree *KDTree2) QueryRadius(point Vector2, radius float32, result []KDTreeNeighbor) []KDTreeNeighbor {
+	return tree.tree._Radius(Vector3{point.X, point.Y, 0}, radius, result)
+}
+
+// QueryBounds appends the indices of the points in rect to result, not ordered
+func (tree *KDTree2) QueryBounds(rect Rect, result []int) []int {
+	min, max := rect.Min(), rect.Max()
+	return tree.tree._Bounds(Vector3{min.X, min.Y, 0}, Vector3{max.X, max.Y, 0}, result)
+}
+
+func (tree *KDTree3) Count() int {
+	return len(tree.tree.points)
+}
+
+// Nearest false if no point is within maxDistance, use math.MaxFloat32 for no limit
+func (tree *KDTree3) Nearest(point Vector3, maxDistance float32) (KDTreeNeighbor, bool) {
+	return tree.tree._Nearest(point, maxDistance, nil)
+}
+
+// NearestFunc the nearest point whose index satisfies predicate
+func (tree *KDTree3) NearestFunc(point Vector3, maxDistance float32, predicate func(index int) bool) (KDTreeNeighbor, bool) {
+	return tree.tree._Nearest(point, maxDistance, predicate)
+}
+
+// KNearest appends at most k neighbors within maxDistance to result, ordered by distance,
+// it does not allocate if result has enough capacity
+func (tree *KDTree3) KNearest(point Vector3, k int, maxDistance float32, result []KDTreeNeighbor) []KDTreeNeighbor {
+	return tree.tree._KNearest(point, k, maxDistance, result)
+}
+
+// QueryRadius appends the neighbors within radius to result, not ordered
+func (tree *KDTree3) QueryRadius(point Vector3, radius float32, result []KDTreeNeighbor) []KDTreeNeighbor {
+	return tree.tree._Radius(point, radius, result)
+}
+
+// QueryBounds appends the indices of the points in bounds to result, not ordered
+func (tree *KDTree3) QueryBounds(bounds Bounds, result []int) []int {
+	return tree.tree._Bounds(bounds.Min(), bounds.Max(), result)
+}
+
+// _KDTree implicit tree, the node of [lo, hi) is the median at (lo + hi) / 2
+type _KDTree struct {
+	points  []Vector3
+	indices []int
+	axes    []uint8
+}
+
+func _NewKDTree(points []Vector3, dims int) _KDTree {
+	tree := _KDTree{points, make([]int, len(points)), make([]uint8, len(points))}
+	for i := range tree.indices {
+		tree.indices[i] = i
+	}
+	tree._Build(0, len(points), dims)
+	return tree
+}
+
+func _V3Axis(v Vector3, axis uint8) float32 {
+	switch axis {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	default:
+		return v.Z
+	}
+}
+
+// _Build split by the axis with the largest spread
+func (tree *_KDTree) _Build(lo, hi int, dims int) {
+	if hi-lo <= 0 {
+		return
+	}
+	mid := (lo + hi) / 2
+	if hi-lo > 1 {
+		min, max := tree.points[lo], tree.points[lo]
+		for _, p := range tree.points[lo+1 : hi] {
+			min = V3Min(min, p)
+			max = V3Max(max, p)
+		}
+		spread := max.Substract(min)
+		var axis uint8
+		if spread.Y > spread.X {
+			axis = 1
+		}
+		if dims == 3 && spread.Z > _V3Axis(spread, axis) {
+			axis = 2
+		}
+		tree._Select(lo, hi, mid, axis)
+		tree.axes[mid] = axis
+	}
+	tree._Build(lo, mid, dims)
+	tree._Build(mid+1, hi, dims)
+}
+
+// _Select introselect, the kth point of [lo, hi) is in place and the points before it are not greater on axis.
+// quickselect falls back to sorting the range after 2*log2(n) bad partitions, so it's O(n log n) at worst
+func (tree *_KDTree) _Select(lo, hi, k int, axis uint8) {
+	budget := 0
+	for n := hi - lo; n > 0; n >>= 1 {
+		budget += 2
+	}
+	hi--
+	for lo < hi {
+		if budget == 0 {
+			sort.Sort(&_KDTreeRange{tree, lo, hi + 1, axis})
+			return
+		}
+		budget--
+
+		// median of three
+		mid := (lo + hi) / 2
+		if tree._Less(mid, lo, axis) {
+			tree._Swap(mid, lo)
+		}
+		if tree._Less(hi, lo, axis) {
+			tree._Swap(hi, lo)
+		}
+		if tree._Less(hi, mid, axis) {
+			tree._Swap(hi, mid)
+		}
+		pivot := _V3Axis(tree.points[mid], axis)
+
+		i, j := lo, hi
+		for i <= j {
+			for _V3Axis(tree.points[i], axis) < pivot {
+				i++
+			}
+			for _V3Axis(tree.points[j], axis) > pivot {
+				j--
+			}
+			if i <= j {
+				tree._Swap(i, j)
+				i++
+				j--
+			}
+		}
+		if k <= j {
+			hi = j
+		} else if k >= i {
+			lo = i
+		} else {
+			return
+		}
+	}
+}
+
+// _KDTreeRange sort.Interface of the points [lo, hi) on axis
+type _KDTreeRange struct {
+	tree   *_KDTree
+	lo, hi int
+	axis   uint8
+}
+
+func (r *_KDTreeRange) Len() int           { return r.hi - r.lo }
+func (r *_KDTreeRange) Less(i, j int) bool { return r.tree._Less(r.lo+i, r.lo+j, r.axis) }
+func (r *_KDTreeRange) Swap(i, j int)      { r.tree._Swap(r.lo+i, r.lo+j) }
+
+func (tree *_KDTree) _Less(i, j int, axis uint8) bool {
+	return _V3Axis(tree.points[i], axis) < _V3Axis(tree.points[j], axis)
+}
+
+func (tree *_KDTree) _Swap(i, j int) {
+	tree.points[i], tree.points[j] = tree.points[j], tree.points[i]
+	tree.indices[i], tree.indices[j] = tree.indices[j], tree.indices[i]
+}
+
+func (tree *_KDTree) _Nearest(point Vector3, maxDistance float32, predicate func(index int) bool) (KDTreeNeighbor, bool) {
+	best := -1
+	sqrBest := maxDistance * maxDistance
+	tree._NearestNode(0, len(tree.points), point, predicate, &best, &sqrBest)
+	if best < 0 {
+		return KDTreeNeighbor{}, false
+	}
+	return KDTreeNeighbor{tree.indices[best], F32Sqrt(sqrBest)}, true
+}
+
+func (tree *_KDTree) _NearestNode(lo, hi int, point Vector3, predicate func(index int) bool, best *int, sqrBest *float32) {
+	if lo >= hi {
+		return
+	}
+	mid := (lo + hi) / 2
+	if d := V3DistanceSqr(point, tree.points[mid]); d <= *sqrBest && (predicate == nil || predicate(tree.indices[mid])) {
+		*best = mid
+		*sqrBest = d
+	}
+	axis := tree.axes[mid]
+	diff := _V3Axis(point, axis) - _V3Axis(tree.points[mid], axis)
+	if diff < 0 {
+		tree._NearestNode(lo, mid, point, predicate, best, sqrBest)
+		if diff*diff <= *sqrBest {
+			tree._NearestNode(mid+1, hi, point, predicate, best, sqrBest)
+		}
+	} else {
+		tree._NearestNode(mid+1, hi, point, predicate, best, sqrBest)
+		if diff*diff <= *sqrBest {
+			tree._NearestNode(lo, mid, point, predicate, best, sqrBest)
+		}
+	}
+}
+
+func (tree *_KDTree) _KNearest(point Vector3, k int, maxDistance float32, result []KDTreeNeighbor) []KDTreeNeighbor {
+	if k <= 0 {
+		return result
+	}
+	start := len(result)
+	// max heap of the squared distances in result[start:]
+	result = tree._KNearestNode(0, len(tree.points), point, k, maxDistance*maxDistance, start, result)
+
+	// heap sort ascending
+	heap := result[start:]
+	for end := len(heap) - 1; end > 0; end-- {
+		heap[0], heap[end] = heap[end], heap[0]
+		_KDTreeSiftDown(heap[:end], 0)
+	}
+	for i := range heap {
+		heap[i].Distance = F32Sqrt(heap[i].Distance)
+	}
+	return result
+}
+
+func (tree *_KDTree) _KNearestNode(lo, hi int, point Vector3, k int, sqrMax float32, start int, result []KDTreeNeighbor) []KDTreeNeighbor {
+	if lo >= hi {
+		return result
+	}
+	mid := (lo + hi) / 2
+	if d := V3DistanceSqr(point, tree.points[mid]); d <= sqrMax {
+		heap := result[start:]
+		if len(heap) < k {
+			result = append(result, KDTreeNeighbor{tree.indices[mid], d})
+			heap = result[start:]
+			// sift up
+			for i := len(heap) - 1; i > 0; {
+				parent := (i - 1) / 2
+				if heap[parent].Distance >= heap[i].Distance {
+					break
+				}
+				heap[parent], heap[i] = heap[i], heap[parent]
+				i = parent
+			}
+		} else if d < heap[0].Distance {
+			heap[0] = KDTreeNeighbor{tree.indices[mid], d}
+			_KDTreeSiftDown(heap, 0)
+		}
+	}
+
+	axis := tree.axes[mid]
+	diff := _V3Axis(point, axis) - _V3Axis(tree.points[mid], axis)
+	if diff < 0 {
+		result = tree._KNearestNode(lo, mid, point, k, sqrMax, start, result)
+		if diff*diff <= _KDTreeBound(result[start:], k, sqrMax) {
+			result = tree._KNearestNode(mid+1, hi, point, k, sqrMax, start, result)
+		}
+	} else {
+		result = tree._KNearestNode(mid+1, hi, point, k, sqrMax, start, result)
+		if diff*diff <= _KDTreeBound(result[start:], k, sqrMax) {
+			result = tree._KNearestNode(lo, mid, point, k, sqrMax, start, result)
+		}
+	}
+	return result
+}
+
+// _KDTreeBound the squared distance a point must beat to enter the heap
+func _KDTreeBound(heap []KDTreeNeighbor, k int, sqrMax float32) float32 {
+	if len(heap) == k {
+		return heap[0].Distance
+	}
+	return sqrMax
+}
+
+func _KDTreeSiftDown(heap []KDTreeNeighbor, i int) {
+	for {
+		largest := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(heap) && heap[left].Distance > heap[largest].Distance {
+			largest = left
+		}
+		if right < len(heap) && heap[right].Distance > heap[largest].Distance {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+		heap[i], heap[largest] = heap[largest], heap[i]
+		i = largest
+	}
+}
+
+func (tree *_KDTree) _Radius(point Vector3, radius float32, result []KDTreeNeighbor) []KDTreeNeighbor {
+	return tree._RadiusNode(0, len(tree.points), point, radius*radius, result)
+}
+
+func (tree *_KDTree) _RadiusNode(lo, hi int, point Vector3, sqrRadius float32, result []KDTreeNeighbor) []KDTreeNeighbor {
+	if lo >= hi {
+		return result
+	}
+	mid := (lo + hi) / 2
+	if d := V3DistanceSqr(point, tree.points[mid]); d <= sqrRadius {
+		result = append(result, KDTreeNeighbor{tree.indices[mid], F32Sqrt(d)})
+	}
+	axis := tree.axes[mid]
+	diff := _V3Axis(point, axis) - _V3Axis(tree.points[mid], axis)
+	if diff <= 0 || diff*diff <= sqrRadius {
+		result = tree._RadiusNode(lo, mid, point, sqrRadius, result)
+	}
+	if diff >= 0 || diff*diff <= sqrRadius {
+		result = tree._RadiusNode(mid+1, hi, point, sqrRadius, result)
+	}
+	return result
+}
+
+func (tree *_KDTree) _Bounds(min, max Vector3, result []int) []int {
+	return tree._BoundsNode(0, len(tree.points), min, max, result)
+}
+
+func (tree *_KDTree) _BoundsNode(lo, hi int, min, max Vector3, result []int) []int {
+	if lo >= hi {
+		return result
+	}
+	mid := (lo + hi) / 2
+	p := tree.points[mid]
+	if p.X >= min.X && p.X <= max.X && p.Y >= min.Y && p.Y <= max.Y && p.Z >= min.Z && p.Z <= max.Z {
+		result = append(result, tree.indices[mid])
+	}
+	axis := tree.axes[mid]
+	if _V3Axis(min, axis) <= _V3Axis(p, axis) {
+		result = tree._BoundsNode(lo, mid, min, max, result)
+	}
+	if _V3Axis(max, axis) >= _V3Axis(p, axis) {
+		result = tree._BoundsNode(mid+1, hi, min, max, result)
+	}
+	return result
+}
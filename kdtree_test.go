@@ -0,0 +1,105 @@
+package gmath
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestKDTree(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	points := make([]Vector3, 1000)
+	for i := range points {
+		points[i] = Vector3{r.Float32() * 100, r.Float32() * 100, r.Float32() * 100}
+	}
+	// duplicates on the split axes
+	points[10] = points[20]
+	points[30] = Vector3{points[40].X, points[50].Y, points[60].Z}
+	tree := NewKDTree3(points)
+
+	for q := 0; q < 50; q++ {
+		query := Vector3{r.Float32() * 100, r.Float32() * 100, r.Float32() * 100}
+		sorted := make([]KDTreeNeighbor, len(points))
+		for i, p := range points {
+			sorted[i] = KDTreeNeighbor{i, V3Distance(query, p)}
+		}
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].Distance < sorted[j].Distance })
+
+		if nearest, ok := tree.Nearest(query, math.MaxFloat32); !ok || nearest.Distance != sorted[0].Distance {
+			t.Error("KDTree3.Nearest")
+		}
+		knn := tree.KNearest(query, 8, math.MaxFloat32, nil)
+		for i := range knn {
+			if len(knn) != 8 || knn[i].Distance != sorted[i].Distance {
+				t.Error("KDTree3.KNearest")
+				break
+			}
+		}
+		limited := tree.KNearest(query, 8, (sorted[3].Distance+sorted[4].Distance)/2, nil)
+		if len(limited) != 4 || limited[3].Distance != sorted[3].Distance {
+			t.Error("KDTree3.KNearest maxDistance")
+		}
+		if found := tree.QueryRadius(query, (sorted[19].Distance+sorted[20].Distance)/2, nil); len(found) != 20 {
+			t.Error("KDTree3.QueryRadius")
+		}
+		box := Bounds{query, Vector3{10, 20, 30}}
+		count := 0
+		for _, p := range points {
+			if box.Contains(p) {
+				count++
+			}
+		}
+		if found := tree.QueryBounds(box, nil); len(found) != count {
+			t.Error("KDTree3.QueryBounds")
+		}
+		odd, ok := tree.NearestFunc(query, math.MaxFloat32, func(index int) bool { return index%2 == 1 })
+		for _, s := range sorted {
+			if s.Index%2 == 1 {
+				if !ok || odd.Distance != s.Distance {
+					t.Error("KDTree3.NearestFunc")
+				}
+				break
+			}
+		}
+	}
+	if _, ok := tree.Nearest(Vector3{-10, -10, -10}, 1); ok {
+		t.Error("KDTree3.Nearest maxDistance")
+	}
+
+	tree2 := NewKDTree2([]Vector2{{0, 0}, {1, 0}, {0, 1}, {5, 5}, {2, 2}})
+	if knn := tree2.KNearest(Vector2{4, 4}, 2, math.MaxFloat32, nil); len(knn) != 2 || knn[0].Index != 3 || knn[1].Index != 4 {
+		t.Error("KDTree2.KNearest")
+	}
+	if found := tree2.QueryRadius(Vector2{0, 0}, 1, nil); len(found) != 3 {
+		t.Error("KDTree2.QueryRadius")
+	}
+	if found := tree2.QueryBounds(RectMinMax(Vector2{1, 0}, Vector2{5, 5}), nil); len(found) != 3 {
+		t.Error("KDTree2.QueryBounds")
+	}
+
+	// sorted, organ pipe and repeated inputs
+	for _, f := range []func(i int) float32{
+		func(i int) float32 { return float32(i) },
+		func(i int) float32 { return float32(500 - F32Abs(float32(i-500))) },
+		func(i int) float32 { return float32(i % 3) },
+	} {
+		line := make([]Vector3, 1000)
+		for i := range line {
+			line[i] = Vector3{f(i), 0, 0}
+		}
+		lineTree := NewKDTree3(line)
+		if nearest, ok := lineTree.Nearest(Vector3{f(123), 1, 0}, math.MaxFloat32); !ok || nearest.Distance != 1 {
+			t.Error("KDTree3 degenerate")
+		}
+	}
+
+	buffer := make([]KDTreeNeighbor, 0, 16)
+	allocs := testing.AllocsPerRun(10, func() {
+		buffer = tree.KNearest(Vector3{50, 50, 50}, 16, math.MaxFloat32, buffer[:0])
+		tree.Nearest(Vector3{50, 50, 50}, math.MaxFloat32)
+	})
+	if allocs != 0 {
+		t.Error("KDTree3 allocs")
+	}
+}
@@ -0,0 +1,424 @@
+package gmath
+
+// LooseTreeOptions zero values use the defaults
+type LooseTreeOptions struct {
+	// MaxDepth the root is depth 0, default 8
+	MaxDepth int
+	// SplitThreshold a leaf splits when it holds more items, and a subtree merges back when it holds at most half, default 8
+	SplitThreshold int
+	// Looseness the loose bounds of a cell are Looseness times the cell, must be > 1, default 2
+	Looseness float32
+}
+
+// SpatialRaycastHit Distance is the distance to the bounds of the item
+type SpatialRaycastHit struct {
+	ID       int64
+	Distance float32
+}
+
+// Quadtree loose quadtree of items keyed by id, the cells split on the XZ plane and Y is not limited
+type Quadtree struct {
+	_LooseTree
+}
+
+// Octree loose octree of items keyed by id
+type Octree struct {
+	_LooseTree
+}
+
+// NewQuadtree the Y of bounds is ignored, items outside bounds are kept in the root
+func NewQuadtree(bounds Bounds, options LooseTreeOptions) *Quadtree {
+	return &Quadtree{_NewLooseTree(bounds, options, false)}
+}
+
+// NewOctree items outside bounds are kept in the root
+func NewOctree(bounds Bounds, options LooseTreeOptions) *Octree {
+	return &Octree{_NewLooseTree(bounds, options, true)}
+}
+
+type _LooseTree struct {
+	options LooseTreeOptions
+	is3D    bool
+	root    *_LooseTreeNode
+	entries map[int64]*_LooseTreeEntry
+}
+
+type _LooseTreeNode struct {
+	center   Vector3
+	halfSize float32
+	depth    int
+	parent   *_LooseTreeNode
+	children []*_LooseTreeNode
+	items    []int64
+	// count of the items in the subtree
+	count int
+	// bounds union of the items in the subtree, only grows until the subtree is empty
+	bounds Bounds
+}
+
+type _LooseTreeEntry struct {
+	bounds Bounds
+	node   *_LooseTreeNode
+	index  int
+}
+
+func _NewLooseTree(bounds Bounds, options LooseTreeOptions, is3D bool) _LooseTree {
+	if options.MaxDepth <= 0 {
+		options.MaxDepth = 8
+	}
+	if options.SplitThreshold <= 0 {
+		options.SplitThreshold = 8
+	}
+	if options.Looseness <= 1 {
+		options.Looseness = 2
+	}
+	halfSize := F32Max(bounds.Extents.X, bounds.Extents.Z)
+	if is3D {
+		halfSize = F32Max(halfSize, bounds.Extents.Y)
+	}
+	if halfSize <= 0 {
+		halfSize = 1
+	}
+	return _LooseTree{
+		options: options,
+		is3D:    is3D,
+		root:    &_LooseTreeNode{center: bounds.Center, halfSize: halfSize},
+		entries: make(map[int64]*_LooseTreeEntry),
+	}
+}
+
+func (tree *_LooseTree) Count() int {
+	return len(tree.entries)
+}
+
+func (tree *_LooseTree) Get(id int64) (Bounds, bool) {
+	entry, ok := tree.entries[id]
+	if !ok {
+		return Bounds{}, false
+	}
+	return entry.bounds, true
+}
+
+// Insert false if the id exists
+func (tree *_LooseTree) Insert(id int64, bounds Bounds) bool {
+	if _, ok := tree.entries[id]; ok {
+		return false
+	}
+	node := tree._Descend(bounds)
+	entry := &_LooseTreeEntry{bounds: bounds}
+	tree.entries[id] = entry
+	node._Add(id, entry)
+	node._Grow(bounds)
+	for n := node.parent; n != nil; n = n.parent {
+		n.count++
+		n._Grow(bounds)
+	}
+	if node.children == nil {
+		tree._TrySplit(node)
+	}
+	return true
+}
+
+// Update false if the id does not exist, the item stays in its node if it still belongs there
+func (tree *_LooseTree) Update(id int64, bounds Bounds) bool {
+	entry, ok := tree.entries[id]
+	if !ok {
+		return false
+	}
+	if tree._Descend(bounds) != entry.node {
+		tree.Remove(id)
+		return tree.Insert(id, bounds)
+	}
+	entry.bounds = bounds
+	for n := entry.node; n != nil; n = n.parent {
+		n.bounds = n.bounds.EncapsulateBounds(bounds)
+	}
+	return true
+}
+
+// Remove false if the id does not exist
+func (tree *_LooseTree) Remove(id int64) bool {
+	entry, ok := tree.entries[id]
+	if !ok {
+		return false
+	}
+	delete(tree.entries, id)
+
+	node := entry.node
+	last := len(node.items) - 1
+	if entry.index != last {
+		moved := node.items[last]
+		node.items[entry.index] = moved
+		tree.entries[moved].index = entry.index
+	}
+	node.items = node.items[:last]
+	for n := node; n != nil; n = n.parent {
+		n.count--
+	}
+
+	// merge the highest subtree which is small enough
+	merge := node
+	for merge.parent != nil && merge.parent.count <= tree.options.SplitThreshold/2 {
+		merge = merge.parent
+	}
+	if merge.children != nil && merge.count <= tree.options.SplitThreshold/2 {
+		tree._Merge(merge, merge)
+	}
+	return true
+}
+
+func (tree *_LooseTree) Clear() {
+	tree.root = &_LooseTreeNode{center: tree.root.center, halfSize: tree.root.halfSize}
+	tree.entries = make(map[int64]*_LooseTreeEntry)
+}
+
+// QueryRadius appends the ids whose bounds overlap the sphere to result
+func (tree *_LooseTree) QueryRadius(center Vector3, radius float32, result []int64) []int64 {
+	tree.ForEachInRadius(center, radius, func(id int64, _ Bounds) bool {
+		result = append(result, id)
+		return true
+	})
+	return result
+}
+
+// QueryBounds appends the ids whose bounds overlap bounds to result
+func (tree *_LooseTree) QueryBounds(bounds Bounds, result []int64) []int64 {
+	tree.ForEachInBounds(bounds, func(id int64, _ Bounds) bool {
+		result = append(result, id)
+		return true
+	})
+	return result
+}
+
+// QueryFrustum appends the ids whose bounds are not outside the frustum to result
+func (tree *_LooseTree) QueryFrustum(frustum *Frustum, result []int64) []int64 {
+	tree.ForEachInFrustum(frustum, func(id int64, _ Bounds) bool {
+		result = append(result, id)
+		return true
+	})
+	return result
+}
+
+// ForEachInRadius return false in fn to stop, fn must not modify the tree
+func (tree *_LooseTree) ForEachInRadius(center Vector3, radius float32, fn func(id int64, bounds Bounds) bool) {
+	sphere := Sphere{center, radius}
+	tree._ForEach(tree.root, func(bounds Bounds) Containment {
+		if !OverlapSphereBounds(sphere, bounds) {
+			return ContainmentOutside
+		}
+		// the farthest corner is inside
+		offset := bounds.Center.Substract(sphere.Center)
+		offset = bounds.Extents.Add(Vector3{F32Abs(offset.X), F32Abs(offset.Y), F32Abs(offset.Z)})
+		if offset.SqrMagnitude() <= sphere.Radius*sphere.Radius {
+			return ContainmentInside
+		}
+		return ContainmentIntersect
+	}, fn)
+}
+
+// ForEachInBounds return false in fn to stop, fn must not modify the tree
+func (tree *_LooseTree) ForEachInBounds(bounds Bounds, fn func(id int64, bounds Bounds) bool) {
+	tree._ForEach(tree.root, func(other Bounds) Containment {
+		if !bounds.Intersects(other) {
+			return ContainmentOutside
+		} else if bounds.ContainsBounds(other) {
+			return ContainmentInside
+		}
+		return ContainmentIntersect
+	}, fn)
+}
+
+// ForEachInFrustum return false in fn to stop, fn must not modify the tree
+func (tree *_LooseTree) ForEachInFrustum(frustum *Frustum, fn func(id int64, bounds Bounds) bool) {
+	tree._ForEach(tree.root, frustum.ClassifyBounds, fn)
+}
+
+// Raycast appends the items whose bounds are hit within maxDistance to result, ordered by distance
+func (tree *_LooseTree) Raycast(ray Ray, maxDistance float32, result []SpatialRaycastHit) []SpatialRaycastHit {
+	start := len(result)
+	result = tree._Raycast(tree.root, ray, maxDistance, result)
+
+	// insertion sort, the hits are few and sort.Slice allocates
+	for i := start + 1; i < len(result); i++ {
+		hit := result[i]
+		j := i
+		for ; j > start && result[j-1].Distance > hit.Distance; j-- {
+			result[j] = result[j-1]
+		}
+		result[j] = hit
+	}
+	return result
+}
+
+func (tree *_LooseTree) _Raycast(node *_LooseTreeNode, ray Ray, maxDistance float32, result []SpatialRaycastHit) []SpatialRaycastHit {
+	if node.count == 0 {
+		return result
+	}
+	if hit, ok := node.bounds.Raycast(ray); !ok || hit.Distance > maxDistance {
+		return result
+	}
+	for _, id := range node.items {
+		if hit, ok := tree.entries[id].bounds.Raycast(ray); ok && hit.Distance <= maxDistance {
+			result = append(result, SpatialRaycastHit{id, hit.Distance})
+		}
+	}
+	for _, child := range node.children {
+		result = tree._Raycast(child, ray, maxDistance, result)
+	}
+	return result
+}
+
+// _ForEach the items of an Inside node are not tested, returns false if fn stops
+func (tree *_LooseTree) _ForEach(node *_LooseTreeNode, test func(bounds Bounds) Containment, fn func(id int64, bounds Bounds) bool) bool {
+	if node.count == 0 {
+		return true
+	}
+	containment := test(node.bounds)
+	if containment == ContainmentOutside {
+		return true
+	}
+	if containment == ContainmentInside {
+		return tree._ForEachAll(node, fn)
+	}
+	for _, id := range node.items {
+		bounds := tree.entries[id].bounds
+		if test(bounds) != ContainmentOutside && !fn(id, bounds) {
+			return false
+		}
+	}
+	for _, child := range node.children {
+		if !tree._ForEach(child, test, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+func (tree *_LooseTree) _ForEachAll(node *_LooseTreeNode, fn func(id int64, bounds Bounds) bool) bool {
+	for _, id := range node.items {
+		if !fn(id, tree.entries[id].bounds) {
+			return false
+		}
+	}
+	for _, child := range node.children {
+		if child.count > 0 && !tree._ForEachAll(child, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// _Descend the deepest existing node the bounds belongs to
+func (tree *_LooseTree) _Descend(bounds Bounds) *_LooseTreeNode {
+	node := tree.root
+	for node.children != nil && tree._FitsChild(node, bounds) {
+		node = node.children[tree._ChildIndex(node, bounds.Center)]
+	}
+	return node
+}
+
+// _FitsChild the loose bounds of the child contains bounds if its center is in the child cell,
+// a center outside the cell of node, i.e. outside the world, never descends
+func (tree *_LooseTree) _FitsChild(node *_LooseTreeNode, bounds Bounds) bool {
+	if node.depth >= tree.options.MaxDepth {
+		return false
+	}
+	offset := bounds.Center.Substract(node.center)
+	if F32Abs(offset.X) > node.halfSize || F32Abs(offset.Z) > node.halfSize || (tree.is3D && F32Abs(offset.Y) > node.halfSize) {
+		return false
+	}
+	extent := F32Max(bounds.Extents.X, bounds.Extents.Z)
+	if tree.is3D {
+		extent = F32Max(extent, bounds.Extents.Y)
+	}
+	return extent <= (tree.options.Looseness-1)*node.halfSize*0.5
+}
+
+func (tree *_LooseTree) _ChildIndex(node *_LooseTreeNode, point Vector3) int {
+	index := 0
+	if point.X >= node.center.X {
+		index |= 1
+	}
+	if point.Z >= node.center.Z {
+		index |= 2
+	}
+	if tree.is3D && point.Y >= node.center.Y {
+		index |= 4
+	}
+	return index
+}
+
+func (tree *_LooseTree) _TrySplit(node *_LooseTreeNode) {
+	if len(node.items) <= tree.options.SplitThreshold || node.depth >= tree.options.MaxDepth {
+		return
+	}
+
+	count := 4
+	if tree.is3D {
+		count = 8
+	}
+	half := node.halfSize * 0.5
+	node.children = make([]*_LooseTreeNode, count)
+	for i := range node.children {
+		offset := Vector3{-half, 0, -half}
+		if i&1 != 0 {
+			offset.X = half
+		}
+		if i&2 != 0 {
+			offset.Z = half
+		}
+		if tree.is3D {
+			offset.Y = -half
+			if i&4 != 0 {
+				offset.Y = half
+			}
+		}
+		node.children[i] = &_LooseTreeNode{center: node.center.Add(offset), halfSize: half, depth: node.depth + 1, parent: node}
+	}
+
+	items := node.items
+	node.items = nil
+	for _, id := range items {
+		entry := tree.entries[id]
+		if tree._FitsChild(node, entry.bounds) {
+			child := node.children[tree._ChildIndex(node, entry.bounds.Center)]
+			child._Add(id, entry)
+			child._Grow(entry.bounds)
+		} else {
+			node._Add(id, entry)
+		}
+	}
+	// _Add counted the items kept in node again
+	node.count -= len(node.items)
+	for _, child := range node.children {
+		tree._TrySplit(child)
+	}
+}
+
+// _Merge move the items of the subtree of node into target
+func (tree *_LooseTree) _Merge(target *_LooseTreeNode, node *_LooseTreeNode) {
+	for _, child := range node.children {
+		for _, id := range child.items {
+			target._Add(id, tree.entries[id])
+			target.count--
+		}
+		tree._Merge(target, child)
+	}
+	node.children = nil
+}
+
+func (node *_LooseTreeNode) _Add(id int64, entry *_LooseTreeEntry) {
+	entry.node = node
+	entry.index = len(node.items)
+	node.items = append(node.items, id)
+	node.count++
+}
+
+// _Grow after count is increased
+func (node *_LooseTreeNode) _Grow(bounds Bounds) {
+	if node.count == 1 {
+		node.bounds = bounds
+	} else {
+		node.bounds = node.bounds.EncapsulateBounds(bounds)
+	}
+}
@@ -0,0 +1,88 @@
+package gmath
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestLooseTree(t *testing.T) {
+	world := Bounds{V3Zero(), Vector3{100, 100, 100}}
+	octree := NewOctree(world, LooseTreeOptions{SplitThreshold: 4})
+	quadtree := NewQuadtree(world, LooseTreeOptions{MaxDepth: 4})
+	trees := []*_LooseTree{&octree._LooseTree, &quadtree._LooseTree}
+
+	view := Matrix4LookAtView(V3Zero(), V3Forward(), V3Up(), RightHanded)
+	projection := Matrix4Perspective(60, 1, 0.1, 50)
+	viewProjection := projection.Multiply(&view)
+	frustum := FrustumFromMatrix(&viewProjection)
+
+	for _, tree := range trees {
+		// a 20x20 grid on XZ, y = (x+z) % 3 * 10
+		for x := 0; x < 20; x++ {
+			for z := 0; z < 20; z++ {
+				center := Vector3{float32(x*10 - 95), float32((x + z) % 3 * 10), float32(z*10 - 95)}
+				tree.Insert(int64(x*20+z), Bounds{center, Vector3{1, 1, 1}})
+			}
+		}
+		// a big item outside the world stays in the root
+		tree.Insert(1000, Bounds{Vector3{500, 0, 0}, Vector3{50, 50, 50}})
+		if tree.Insert(0, Bounds{}) || tree.Count() != 401 {
+			t.Error("LooseTree.Insert")
+		}
+		if tree.root.children == nil || tree.entries[1000].node != tree.root {
+			t.Error("LooseTree.Insert outside")
+		}
+
+		ids := tree.QueryRadius(Vector3{10, 0, 10}, 8, nil)
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		// (5, 5), (5, 15), (15, 5), (15, 15) are x, z = 10, 11, only (5, 15) and (15, 5) are at y = 0
+		if len(ids) != 2 || ids[0] != 10*20+11 || ids[1] != 11*20+10 {
+			t.Error("LooseTree.QueryRadius")
+		}
+		if ids := tree.QueryBounds(Bounds{Vector3{0, 0, 0}, Vector3{20, 100, 20}}, nil); len(ids) != 16 {
+			t.Error("LooseTree.QueryBounds")
+		}
+		if ids := tree.QueryBounds(Bounds{Vector3{460, 0, 0}, Vector3{20, 10, 20}}, nil); len(ids) != 1 || ids[0] != 1000 {
+			t.Error("LooseTree.QueryBounds root")
+		}
+		if ids := tree.QueryFrustum(&frustum, nil); len(ids) == 0 || len(ids) > 100 {
+			t.Error("LooseTree.QueryFrustum")
+		}
+		for _, id := range tree.QueryFrustum(&frustum, nil) {
+			x, z := id/20, id%20
+			if z*10-95 < 0 || x*10-95 > z*10-95+1 {
+				t.Error("LooseTree.QueryFrustum")
+			}
+		}
+
+		// the items at y = 0 along x = 5
+		hits := tree.Raycast(NewRay(Vector3{5, 0, -200}, V3Forward()), 1000, nil)
+		if len(hits) != 6 || hits[0].ID != 10*20+2 || !sort.SliceIsSorted(hits, func(i, j int) bool { return hits[i].Distance < hits[j].Distance }) {
+			t.Error("LooseTree.Raycast")
+		}
+		buffer := make([]SpatialRaycastHit, 0, 16)
+		allocs := testing.AllocsPerRun(10, func() {
+			buffer = tree.Raycast(NewRay(Vector3{5, 0, -200}, V3Forward()), 1000, buffer[:0])
+		})
+		if allocs != 0 || len(buffer) != 6 || buffer[0].ID != 10*20+2 {
+			t.Error("LooseTree.Raycast allocs")
+		}
+
+		tree.Update(10*20+11, Bounds{Vector3{-50, 0, -50}, V3One()})
+		tree.Update(11*20+10, Bounds{Vector3{6, 0, 6}, V3One()})
+		if ids := tree.QueryRadius(Vector3{10, 0, 10}, 8, nil); len(ids) != 1 || ids[0] != 11*20+10 {
+			t.Error("LooseTree.Update")
+		}
+		for id := int64(0); id < 400; id++ {
+			if id != 7 && !tree.Remove(id) {
+				t.Error("LooseTree.Remove")
+			}
+		}
+		if tree.Remove(0) || tree.Count() != 2 || len(tree.QueryBounds(world, nil)) != 1 {
+			t.Error("LooseTree.Remove count")
+		}
+	}
+	if octree.root.children != nil || quadtree.root.children != nil {
+		t.Error("LooseTree merge")
+	}
+}
@@ -0,0 +1,232 @@
+package gmath
+
+// Matrix3 3x3 rotation, scale and normal matrix, or 2D affine transform, same layout as Matrix4
+type Matrix3 struct {
+	m00 float32
+	m10 float32
+	m20 float32
+
+	m01 float32
+	m11 float32
+	m21 float32
+
+	m02 float32
+	m12 float32
+	m22 float32
+}
+
+func Matrix3Identity() Matrix3 {
+	result := Matrix3{}
+	result.m00 = 1
+	result.m11 = 1
+	result.m22 = 1
+	return result
+}
+
+// Matrix3FromColumns the columns are the basis vectors, e.g. right, up, forward
+func Matrix3FromColumns(column0, column1, column2 Vector3) Matrix3 {
+	result := Matrix3{}
+	result.m00 = column0.X
+	result.m10 = column0.Y
+	result.m20 = column0.Z
+
+	result.m01 = column1.X
+	result.m11 = column1.Y
+	result.m21 = column1.Z
+
+	result.m02 = column2.X
+	result.m12 = column2.Y
+	result.m22 = column2.Z
+	return result
+}
+
+func Matrix3FromRows(row0, row1, row2 Vector3) Matrix3 {
+	result := Matrix3FromColumns(row0, row1, row2)
+	return result.Transpose()
+}
+
+func Matrix3FromRotation(q Quaternion) Matrix3 {
+	m := Matrix4FromRotation(q)
+	return Matrix3FromMatrix4(&m)
+}
+
+func Matrix3FromScale(vector Vector3) Matrix3 {
+	result := Matrix3{}
+	result.m00 = vector.X
+	result.m11 = vector.Y
+	result.m22 = vector.Z
+	return result
+}
+
+// Matrix3FromMatrix4 the upper-left 3x3
+func Matrix3FromMatrix4(matrix *Matrix4) Matrix3 {
+	result := Matrix3{}
+	result.m00 = matrix.m00
+	result.m10 = matrix.m10
+	result.m20 = matrix.m20
+
+	result.m01 = matrix.m01
+	result.m11 = matrix.m11
+	result.m21 = matrix.m21
+
+	result.m02 = matrix.m02
+	result.m12 = matrix.m12
+	result.m22 = matrix.m22
+	return result
+}
+
+// Matrix3Normal the inverse transpose of the upper-left 3x3, for transforming normals
+func Matrix3Normal(matrix *Matrix4) Matrix3 {
+	result := Matrix3FromMatrix4(matrix)
+	result = result.Inverse()
+	return result.Transpose()
+}
+
+// Matrix3TRS2D 2D affine, rotate clockwise same as Vector2.Rotate
+func Matrix3TRS2D(pos Vector2, angle AngleRadian, scale Vector2) Matrix3 {
+	sin := Sin(angle)
+	cos := Cos(angle)
+
+	result := Matrix3{}
+	result.m00 = cos * scale.X
+	result.m10 = -sin * scale.X
+	result.m01 = sin * scale.Y
+	result.m11 = cos * scale.Y
+	result.m02 = pos.X
+	result.m12 = pos.Y
+	result.m22 = 1
+	return result
+}
+
+// ToMatrix4 the upper-left 3x3, the others are identity
+func (matrix *Matrix3) ToMatrix4() Matrix4 {
+	result := Matrix4Identity()
+	result.m00 = matrix.m00
+	result.m10 = matrix.m10
+	result.m20 = matrix.m20
+
+	result.m01 = matrix.m01
+	result.m11 = matrix.m11
+	result.m21 = matrix.m21
+
+	result.m02 = matrix.m02
+	result.m12 = matrix.m12
+	result.m22 = matrix.m22
+	return result
+}
+
+// ToQuaternion the matrix must be a pure rotation
+func (matrix *Matrix3) ToQuaternion() Quaternion {
+	return _QuaternionFromMatrix(
+		matrix.m00, matrix.m01, matrix.m02,
+		matrix.m10, matrix.m11, matrix.m12,
+		matrix.m20, matrix.m21, matrix.m22)
+}
+
+func (matrix *Matrix3) GetColumn(index int) Vector3 {
+	switch index {
+	case 0:
+		return Vector3{matrix.m00, matrix.m10, matrix.m20}
+	case 1:
+		return Vector3{matrix.m01, matrix.m11, matrix.m21}
+	case 2:
+		return Vector3{matrix.m02, matrix.m12, matrix.m22}
+	default:
+		panic("index out of range")
+	}
+}
+
+func (matrix *Matrix3) GetRow(index int) Vector3 {
+	switch index {
+	case 0:
+		return Vector3{matrix.m00, matrix.m01, matrix.m02}
+	case 1:
+		return Vector3{matrix.m10, matrix.m11, matrix.m12}
+	case 2:
+		return Vector3{matrix.m20, matrix.m21, matrix.m22}
+	default:
+		panic("index out of range")
+	}
+}
+
+func (left *Matrix3) Multiply(right *Matrix3) Matrix3 {
+	result := Matrix3{}
+	result.m00 = left.m00*right.m00 + left.m01*right.m10 + left.m02*right.m20
+	result.m01 = left.m00*right.m01 + left.m01*right.m11 + left.m02*right.m21
+	result.m02 = left.m00*right.m02 + left.m01*right.m12 + left.m02*right.m22
+	result.m10 = left.m10*right.m00 + left.m11*right.m10 + left.m12*right.m20
+	result.m11 = left.m10*right.m01 + left.m11*right.m11 + left.m12*right.m21
+	result.m12 = left.m10*right.m02 + left.m11*right.m12 + left.m12*right.m22
+	result.m20 = left.m20*right.m00 + left.m21*right.m10 + left.m22*right.m20
+	result.m21 = left.m20*right.m01 + left.m21*right.m11 + left.m22*right.m21
+	result.m22 = left.m20*right.m02 + left.m21*right.m12 + left.m22*right.m22
+	return result
+}
+
+func (matrix *Matrix3) Transpose() Matrix3 {
+	result := Matrix3{}
+	result.m00 = matrix.m00
+	result.m01 = matrix.m10
+	result.m02 = matrix.m20
+
+	result.m10 = matrix.m01
+	result.m11 = matrix.m11
+	result.m12 = matrix.m21
+
+	result.m20 = matrix.m02
+	result.m21 = matrix.m12
+	result.m22 = matrix.m22
+	return result
+}
+
+func (matrix *Matrix3) Determinant() float32 {
+	return matrix.m00*(matrix.m11*matrix.m22-matrix.m12*matrix.m21) -
+		matrix.m01*(matrix.m10*matrix.m22-matrix.m12*matrix.m20) +
+		matrix.m02*(matrix.m10*matrix.m21-matrix.m11*matrix.m20)
+}
+
+// Inverse return identity if the matrix is singular
+func (matrix *Matrix3) Inverse() Matrix3 {
+	det := matrix.Determinant()
+	if det == 0 {
+		//panic
+		return Matrix3Identity()
+	}
+
+	detInverse := 1.0 / det
+	inv := Matrix3{}
+	inv.m00 = (matrix.m11*matrix.m22 - matrix.m12*matrix.m21) * detInverse
+	inv.m01 = (matrix.m02*matrix.m21 - matrix.m01*matrix.m22) * detInverse
+	inv.m02 = (matrix.m01*matrix.m12 - matrix.m02*matrix.m11) * detInverse
+	inv.m10 = (matrix.m12*matrix.m20 - matrix.m10*matrix.m22) * detInverse
+	inv.m11 = (matrix.m00*matrix.m22 - matrix.m02*matrix.m20) * detInverse
+	inv.m12 = (matrix.m02*matrix.m10 - matrix.m00*matrix.m12) * detInverse
+	inv.m20 = (matrix.m10*matrix.m21 - matrix.m11*matrix.m20) * detInverse
+	inv.m21 = (matrix.m01*matrix.m20 - matrix.m00*matrix.m21) * detInverse
+	inv.m22 = (matrix.m00*matrix.m11 - matrix.m01*matrix.m10) * detInverse
+	return inv
+}
+
+func (matrix *Matrix3) MultiplyV3(v Vector3) Vector3 {
+	result := Vector3{}
+	result.X = matrix.m00*v.X + matrix.m01*v.Y + matrix.m02*v.Z
+	result.Y = matrix.m10*v.X + matrix.m11*v.Y + matrix.m12*v.Z
+	result.Z = matrix.m20*v.X + matrix.m21*v.Y + matrix.m22*v.Z
+	return result
+}
+
+// MultiplyPoint2 2D affine
+func (matrix *Matrix3) MultiplyPoint2(point Vector2) Vector2 {
+	result := Vector2{}
+	result.X = matrix.m00*point.X + matrix.m01*point.Y + matrix.m02
+	result.Y = matrix.m10*point.X + matrix.m11*point.Y + matrix.m12
+	return result
+}
+
+// MultiplyDir2 2D affine, ignore the translation
+func (matrix *Matrix3) MultiplyDir2(dir Vector2) Vector2 {
+	result := Vector2{}
+	result.X = matrix.m00*dir.X + matrix.m01*dir.Y
+	result.Y = matrix.m10*dir.X + matrix.m11*dir.Y
+	return result
+}
@@ -0,0 +1,46 @@
+package gmath
+
+import "testing"
+
+func TestMatrix3(t *testing.T) {
+	q := QuaternionFromEulerAngle(Vector3{10, 20, 30})
+	m1 := Matrix3FromRotation(q)
+	if !m1.ToQuaternion().Equal(q) {
+		t.Error("ToQuaternion")
+	}
+	if !F32Equal(m1.Determinant(), 1) {
+		t.Error("Determinant")
+	}
+
+	v := Vector3{1, 2, 3}
+	if !m1.MultiplyV3(v).Equal(q.MultiplyV3(v)) {
+		t.Error("MultiplyV3")
+	}
+
+	m2 := m1.Inverse()
+	m3 := m1.Transpose()
+	m4 := m1.Multiply(&m2)
+	if !m2.MultiplyV3(v).Equal(m3.MultiplyV3(v)) || !m4.MultiplyV3(v).Equal(v) {
+		t.Error("Inverse")
+	}
+
+	m5 := Matrix4TRS(Vector3{1, 2, 3}, q, Vector3{1, 2, 3})
+	m6 := Matrix3FromMatrix4(&m5)
+	m7 := m6.ToMatrix4()
+	if !m7.MultiplyDir3(v).Equal(m5.MultiplyDir3(v)) {
+		t.Error("ToMatrix4")
+	}
+
+	// the transformed normal is still perpendicular to the transformed surface
+	normal := Matrix3Normal(&m5)
+	n := normal.MultiplyV3(V3Up())
+	if !F32Equal(n.Dot(m5.MultiplyDir3(V3Right())), 0) || !F32Equal(n.Dot(m5.MultiplyDir3(V3Forward())), 0) {
+		t.Error("Matrix3Normal")
+	}
+
+	position := Vector2{1, 2}
+	m8 := Matrix3TRS2D(position, AngleDegree(30).ToRadian(), V2One())
+	if !m8.MultiplyPoint2(Vector2{3, 4}).Equal(position.Add(Vector2{3, 4}.RotateDegree(30))) {
+		t.Error("MultiplyPoint2")
+	}
+}
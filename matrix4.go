@@ -150,6 +150,30 @@ func Matrix4FromScale(vector Vector3) Matrix4 {
 	return result
 }
 
+func Matrix4FromColumns(column0, column1, column2, column3 Vector4) Matrix4 {
+	result := Matrix4{}
+	result.m00 = column0.X
+	result.m10 = column0.Y
+	result.m20 = column0.Z
+	result.m30 = column0.W
+
+	result.m01 = column1.X
+	result.m11 = column1.Y
+	result.m21 = column1.Z
+	result.m31 = column1.W
+
+	result.m02 = column2.X
+	result.m12 = column2.Y
+	result.m22 = column2.Z
+	result.m32 = column2.W
+
+	result.m03 = column3.X
+	result.m13 = column3.Y
+	result.m23 = column3.Z
+	result.m33 = column3.W
+	return result
+}
+
 func (matrix *Matrix4) GetColumn(index int) Vector4 {
 	switch index {
 	case 0:
@@ -411,3 +435,88 @@ func (matrix *Matrix4) MultiplyDir3(dir Vector3) Vector3 {
 	result.Z = matrix.m20*dir.X + matrix.m21*dir.Y + matrix.m22*dir.Z
 	return result
 }
+
+// GetRotation see Decompose
+func (matrix *Matrix4) GetRotation() Quaternion {
+	_, rot, _, _ := matrix.Decompose()
+	return rot
+}
+
+// GetLossyScale see Decompose
+func (matrix *Matrix4) GetLossyScale() Vector3 {
+	_, _, scale, _ := matrix.Decompose()
+	return scale
+}
+
+// Decompose the inverse of Matrix4TRS, ok is false if the matrix is singular or projective
+//
+// Negative scale is returned as negative scale.X.
+// The rotation of a sheared matrix is the nearest rotation by polar decomposition,
+// and the scale is the diagonal of the remaining stretch
+func (matrix *Matrix4) Decompose() (pos Vector3, rot Quaternion, scale Vector3, ok bool) {
+	pos = matrix.GetPosition()
+	rot = QuaternionIdentity()
+	scale = V3One()
+
+	m := Matrix3FromMatrix4(matrix)
+	det := m.Determinant()
+	if F32IsNaN(det) || F32IsZero2(det, 1e-12) {
+		return pos, rot, scale, false
+	}
+	ok = F32IsZero(matrix.m30) && F32IsZero(matrix.m31) && F32IsZero(matrix.m32) && F32Equal(matrix.m33, 1)
+
+	// remove the reflection
+	if det < 0 {
+		m.m00, m.m10, m.m20 = -m.m00, -m.m10, -m.m20
+	}
+
+	x := m.GetColumn(0)
+	y := m.GetColumn(1)
+	z := m.GetColumn(2)
+	scale = Vector3{x.NormalizeSelf(), y.NormalizeSelf(), z.NormalizeSelf()}
+
+	var r Matrix3
+	if F32IsZero2(x.Dot(y), 1e-4) && F32IsZero2(x.Dot(z), 1e-4) && F32IsZero2(y.Dot(z), 1e-4) {
+		r = Matrix3FromColumns(x, y, z)
+	} else {
+		r = _Matrix3Polar(&m)
+		rt := r.Transpose()
+		stretch := rt.Multiply(&m)
+		scale = Vector3{stretch.m00, stretch.m11, stretch.m22}
+	}
+	if det < 0 {
+		scale.X = -scale.X
+	}
+
+	rot = r.ToQuaternion()
+	rot.NormalizeSelf()
+	return pos, rot, scale, ok
+}
+
+// _Matrix3Polar the rotation of the polar decomposition, m must have positive determinant
+func _Matrix3Polar(m *Matrix3) Matrix3 {
+	r := *m
+	for i := 0; i < 32; i++ {
+		inv := r.Inverse()
+		invT := inv.Transpose()
+		next := Matrix3{}
+		next.m00 = (r.m00 + invT.m00) * 0.5
+		next.m10 = (r.m10 + invT.m10) * 0.5
+		next.m20 = (r.m20 + invT.m20) * 0.5
+		next.m01 = (r.m01 + invT.m01) * 0.5
+		next.m11 = (r.m11 + invT.m11) * 0.5
+		next.m21 = (r.m21 + invT.m21) * 0.5
+		next.m02 = (r.m02 + invT.m02) * 0.5
+		next.m12 = (r.m12 + invT.m12) * 0.5
+		next.m22 = (r.m22 + invT.m22) * 0.5
+
+		diff := F32Abs(next.m00-r.m00) + F32Abs(next.m10-r.m10) + F32Abs(next.m20-r.m20) +
+			F32Abs(next.m01-r.m01) + F32Abs(next.m11-r.m11) + F32Abs(next.m21-r.m21) +
+			F32Abs(next.m02-r.m02) + F32Abs(next.m12-r.m12) + F32Abs(next.m22-r.m22)
+		r = next
+		if diff < 1e-6 {
+			break
+		}
+	}
+	return r
+}
@@ -0,0 +1,94 @@
+package gmath
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Matrix4FromArray column-major, [m00, m10, m20, m30, m01, m11, ... m33]
+func Matrix4FromArray(values [16]float32) Matrix4 {
+	result := Matrix4{}
+	result.m00, result.m10, result.m20, result.m30 = values[0], values[1], values[2], values[3]
+	result.m01, result.m11, result.m21, result.m31 = values[4], values[5], values[6], values[7]
+	result.m02, result.m12, result.m22, result.m32 = values[8], values[9], values[10], values[11]
+	result.m03, result.m13, result.m23, result.m33 = values[12], values[13], values[14], values[15]
+	return result
+}
+
+// ToArray column-major, [m00, m10, m20, m30, m01, m11, ... m33]
+func (matrix *Matrix4) ToArray() [16]float32 {
+	return [16]float32{
+		matrix.m00, matrix.m10, matrix.m20, matrix.m30,
+		matrix.m01, matrix.m11, matrix.m21, matrix.m31,
+		matrix.m02, matrix.m12, matrix.m22, matrix.m32,
+		matrix.m03, matrix.m13, matrix.m23, matrix.m33,
+	}
+}
+
+// MarshalJSON 16 numbers array, column-major same as ToArray
+func (matrix Matrix4) MarshalJSON() ([]byte, error) {
+	return json.Marshal(matrix.ToArray())
+}
+
+func (matrix *Matrix4) UnmarshalJSON(data []byte) error {
+	var values []float32
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	if len(values) != 16 {
+		return fmt.Errorf("gmath: Matrix4 needs 16 numbers, got %d", len(values))
+	}
+	var array [16]float32
+	copy(array[:], values)
+	*matrix = Matrix4FromArray(array)
+	return nil
+}
+
+// MarshalText 16 numbers separated by space, column-major same as ToArray
+func (matrix Matrix4) MarshalText() ([]byte, error) {
+	values := matrix.ToArray()
+	texts := make([]string, len(values))
+	for i, v := range values {
+		texts[i] = fmt.Sprint(v)
+	}
+	return []byte(strings.Join(texts, " ")), nil
+}
+
+func (matrix *Matrix4) UnmarshalText(text []byte) error {
+	texts := strings.Fields(string(text))
+	if len(texts) != 16 {
+		return fmt.Errorf("gmath: Matrix4 needs 16 numbers, got %d", len(texts))
+	}
+	var values [16]float32
+	for i, v := range texts {
+		if _, err := fmt.Sscan(v, &values[i]); err != nil {
+			return fmt.Errorf("gmath: Matrix4 invalid number %q: %w", v, err)
+		}
+	}
+	*matrix = Matrix4FromArray(values)
+	return nil
+}
+
+// MarshalBinary 16 little-endian floats, column-major same as ToArray
+func (matrix Matrix4) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.LittleEndian, matrix.ToArray()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (matrix *Matrix4) UnmarshalBinary(data []byte) error {
+	var values [16]float32
+	if len(data) != binary.Size(values) {
+		return fmt.Errorf("gmath: Matrix4 needs %d bytes, got %d", binary.Size(values), len(data))
+	}
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &values); err != nil {
+		return err
+	}
+	*matrix = Matrix4FromArray(values)
+	return nil
+}
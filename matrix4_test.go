@@ -1,6 +1,10 @@
 package gmath
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -22,3 +26,136 @@ func TestMatrix4(t *testing.T) {
 		t.Error("Transpose")
 	}
 }
+
+func TestMatrix4Projection(t *testing.T) {
+	eye := Vector3{1, 2, 3}
+	forward := Vector3{1, 0, 1}.Normalize()
+	for _, handedness := range []Handedness{RightHanded, LeftHanded} {
+		for _, depth := range []DepthRange{DepthNegativeOneToOne, DepthZeroToOne} {
+			view := Matrix4LookAtView(eye, eye.Add(forward), V3Up(), handedness)
+			for _, projection := range []Matrix4{
+				Matrix4PerspectiveClip(60, 2, 1, 100, handedness, depth),
+				Matrix4FrustumClip(-1, 1, -0.5, 0.5, 1, 100, handedness, depth),
+				Matrix4OrthoClip(-1, 1, -0.5, 0.5, 1, 100, handedness, depth),
+			} {
+				m := projection.Multiply(&view)
+				nearDepth := float32(-1)
+				if depth == DepthZeroToOne {
+					nearDepth = 0
+				}
+				if !m.MultiplyPoint3(eye.Add(forward)).Equal(Vector3{0, 0, nearDepth}) {
+					t.Error("near", handedness, depth, m.MultiplyPoint3(eye.Add(forward)))
+				}
+				if !m.MultiplyPoint3(eye.Add(forward.Scale(100))).Equal(Vector3{0, 0, 1}) {
+					t.Error("far", handedness, depth)
+				}
+
+				// right of the camera is right of the screen
+				front := eye.Add(forward.Scale(10))
+				p := m.MultiplyPoint3(front.Add(V3Up().Cross(forward)))
+				if p.X <= 0 || !F32Equal(p.Y, 0) {
+					t.Error("right", handedness, depth)
+				}
+			}
+		}
+	}
+
+	m := Matrix4LookAt(eye, eye.Add(forward), V3Up())
+	if !m.MultiplyDir3(V3Forward()).Equal(forward) || !m.GetPosition().Equal(eye) {
+		t.Error("Matrix4LookAt")
+	}
+}
+
+func TestMatrix4Decompose(t *testing.T) {
+	for _, scale := range []Vector3{{1, 2, 3}, {-1, 2, 3}, {0.5, 0.5, 0.5}} {
+		pos := Vector3{4, 5, 6}
+		rot := QuaternionFromEulerAngle(Vector3{10, 20, 30})
+		m := Matrix4TRS(pos, rot, scale)
+
+		pos2, rot2, scale2, ok := m.Decompose()
+		if !ok || !pos2.Equal(pos) || !rot2.Equal(rot) || !scale2.Equal(scale) {
+			t.Error("Decompose", scale, pos2, rot2, scale2)
+		}
+
+		m2 := Matrix4TRS(pos2, rot2, scale2)
+		v := Vector3{7, 8, 9}
+		if V3Distance(m.MultiplyPoint3x4(v), m2.MultiplyPoint3x4(v)) > 1e-4 {
+			t.Error("Decompose round trip", scale)
+		}
+	}
+
+	// shear, R is a proper rotation and the remaining stretch R^T*M is symmetric positive-definite with the scale on the diagonal
+	shear := Matrix4FromColumns(Vector4{1, 0, 0, 0}, Vector4{0.5, 1, 0, 0}, Vector4{0, 0, 1, 0}, Vector4{0, 0, 0, 1})
+	trs := Matrix4TRS(Vector3{4, 5, 6}, QuaternionFromEulerAngle(Vector3{0, 45, 0}), Vector3{1, 2, 3})
+	m := trs.Multiply(&shear)
+	pos2, rot2, scale2, ok := m.Decompose()
+	r2 := Matrix3FromRotation(rot2)
+	rt := r2.Transpose()
+	m3 := Matrix3FromMatrix4(&m)
+	stretch := rt.Multiply(&m3)
+	if !ok || !F32Equal(r2.Determinant(), 1) || !pos2.Equal(Vector3{4, 5, 6}) ||
+		!stretch.GetRow(0).Equal(stretch.GetColumn(0)) || !stretch.GetRow(1).Equal(stretch.GetColumn(1)) ||
+		stretch.m00 <= 0 || stretch.m00*stretch.m11-stretch.m01*stretch.m10 <= 0 || stretch.Determinant() <= 0 ||
+		!F32Equal(stretch.m00, scale2.X) || !F32Equal(stretch.m11, scale2.Y) || !F32Equal(stretch.m22, scale2.Z) {
+		t.Error("Decompose shear")
+	}
+
+	// a known polar decomposition, rotation * symmetric positive-definite stretch
+	rot := QuaternionFromEulerAngle(Vector3{10, 20, 30})
+	knownRotation := Matrix3FromRotation(rot)
+	knownStretch := Matrix3FromRows(Vector3{2, 0.5, 0}, Vector3{0.5, 1, 0.2}, Vector3{0, 0.2, 3})
+	known := knownRotation.Multiply(&knownStretch)
+	m = known.ToMatrix4()
+	if _, rot2, scale2, ok := m.Decompose(); !ok || QuaternionAngle(rot, rot2) > 0.01 || !scale2.Equal(Vector3{2, 1, 3}) {
+		t.Error("Decompose polar")
+	}
+
+	singular := Matrix4FromScale(Vector3{1, 0, 1})
+	if _, _, _, ok := singular.Decompose(); ok {
+		t.Error("Decompose singular")
+	}
+}
+
+func TestMatrix4Encoding(t *testing.T) {
+	type Node struct {
+		Name   string  `json:"name"`
+		Matrix Matrix4 `json:"matrix"`
+	}
+	m := Matrix4TRS(Vector3{0.1, 1, 2}, QuaternionFromEulerAngle(Vector3{10, 20, 30}), Vector3{1, 2, 3})
+
+	if Matrix4FromArray(m.ToArray()) != m {
+		t.Error("Matrix4FromArray")
+	}
+
+	data, err := json.Marshal(Node{"a", Matrix4FromTranslate(Vector3{1, 2, 3})})
+	if err != nil || string(data) != `{"name":"a","matrix":[1,0,0,0,0,1,0,0,0,0,1,0,1,2,3,1]}` {
+		t.Error("MarshalJSON", string(data))
+	}
+
+	node := Node{}
+	data, _ = json.Marshal(Node{"a", m})
+	if err := json.Unmarshal(data, &node); err != nil || node.Matrix != m {
+		t.Error("UnmarshalJSON")
+	}
+	if err := json.Unmarshal([]byte(`{"matrix":[1,2,3]}`), &node); err == nil {
+		t.Error("UnmarshalJSON length")
+	}
+
+	text, _ := m.MarshalText()
+	m2 := Matrix4{}
+	if err := m2.UnmarshalText(text); err != nil || m2 != m {
+		t.Error("UnmarshalText")
+	}
+	if err := m2.UnmarshalText([]byte("1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 x")); err == nil || errors.Unwrap(err) == nil {
+		t.Error("UnmarshalText invalid")
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(Node{"a", m}); err != nil {
+		t.Error("gob Encode", err)
+	}
+	node = Node{}
+	if err := gob.NewDecoder(&buf).Decode(&node); err != nil || node.Matrix != m {
+		t.Error("gob Decode", err)
+	}
+}
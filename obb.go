@@ -0,0 +1,115 @@
+package gmath
+
+// OBB oriented bounding box, Extents are in the local space of Rotation
+type OBB struct {
+	Center   Vector3    `json:"center"`
+	Extents  Vector3    `json:"extents"`
+	Rotation Quaternion `json:"rotation"`
+}
+
+func OBBFromBounds(bounds Bounds) OBB {
+	return OBB{bounds.Center, bounds.Extents, QuaternionIdentity()}
+}
+
+// Axes the local x, y, z axes in world space
+func (obb OBB) Axes() [3]Vector3 {
+	return [3]Vector3{
+		obb.Rotation.MultiplyV3(V3Right()),
+		obb.Rotation.MultiplyV3(V3Up()),
+		obb.Rotation.MultiplyV3(V3Forward()),
+	}
+}
+
+// Corners the 8 corners in world space
+func (obb OBB) Corners() [8]Vector3 {
+	axes := obb.Axes()
+	x := axes[0].Scale(obb.Extents.X)
+	y := axes[1].Scale(obb.Extents.Y)
+	z := axes[2].Scale(obb.Extents.Z)
+	var corners [8]Vector3
+	for i := range corners {
+		corner := obb.Center
+		if i&1 == 0 {
+			corner = corner.Substract(x)
+		} else {
+			corner = corner.Add(x)
+		}
+		if i&2 == 0 {
+			corner = corner.Substract(y)
+		} else {
+			corner = corner.Add(y)
+		}
+		if i&4 == 0 {
+			corner = corner.Substract(z)
+		} else {
+			corner = corner.Add(z)
+		}
+		corners[i] = corner
+	}
+	return corners
+}
+
+func (obb OBB) GetBounds() Bounds {
+	axes := obb.Axes()
+	e := obb.Extents
+	return Bounds{obb.Center, Vector3{
+		F32Abs(axes[0].X)*e.X + F32Abs(axes[1].X)*e.Y + F32Abs(axes[2].X)*e.Z,
+		F32Abs(axes[0].Y)*e.X + F32Abs(axes[1].Y)*e.Y + F32Abs(axes[2].Y)*e.Z,
+		F32Abs(axes[0].Z)*e.X + F32Abs(axes[1].Z)*e.Y + F32Abs(axes[2].Z)*e.Z,
+	}}
+}
+
+// ToLocal world point to the local space of the box, the box is Bounds{V3Zero(), Extents} there
+func (obb OBB) ToLocal(point Vector3) Vector3 {
+	return obb.Rotation.Inverse().MultiplyV3(point.Substract(obb.Center))
+}
+
+func (obb OBB) ToWorld(point Vector3) Vector3 {
+	return obb.Center.Add(obb.Rotation.MultiplyV3(point))
+}
+
+func (obb OBB) Contains(point Vector3) bool {
+	return obb._LocalBounds().Contains(obb.ToLocal(point))
+}
+
+func (obb OBB) ClosestPoint(point Vector3) Vector3 {
+	return obb.ToWorld(obb._LocalBounds().ClosestPoint(obb.ToLocal(point)))
+}
+
+// SqrDistance zero if the point is inside
+func (obb OBB) SqrDistance(point Vector3) float32 {
+	return obb._LocalBounds().SqrDistance(obb.ToLocal(point))
+}
+
+// Transform shear can not be represented, the extents are scaled by the length of the transformed axes
+func (obb OBB) Transform(matrix *Matrix4) OBB {
+	axes := obb.Axes()
+	x := matrix.MultiplyDir3(axes[0].Scale(obb.Extents.X))
+	y := matrix.MultiplyDir3(axes[1].Scale(obb.Extents.Y))
+	z := matrix.MultiplyDir3(axes[2].Scale(obb.Extents.Z))
+	rotation := matrix.GetRotation().Multiply(obb.Rotation)
+	return OBB{matrix.MultiplyPoint3x4(obb.Center), Vector3{x.Magnitude(), y.Magnitude(), z.Magnitude()}, rotation}
+}
+
+// Raycast hit at distance 0 if the origin is inside
+func (obb OBB) Raycast(ray Ray) (RaycastHit, bool) {
+	inverse := obb.Rotation.Inverse()
+	local := Ray{inverse.MultiplyV3(ray.Origin.Substract(obb.Center)), inverse.MultiplyV3(ray.Direction)}
+	hit, ok := obb._LocalBounds().Raycast(local)
+	if !ok {
+		return RaycastHit{}, false
+	}
+	return RaycastHit{hit.Distance, ray.GetPoint(hit.Distance), obb.Rotation.MultiplyV3(hit.Normal)}, true
+}
+
+func (obb OBB) _LocalBounds() Bounds {
+	return Bounds{V3Zero(), obb.Extents}
+}
+
+// _ProjectedRadius the half length of the projection onto axis
+func (obb OBB) _ProjectedRadius(axis Vector3) float32 {
+	axes := obb.Axes()
+	return F32Abs(axes[0].Dot(axis))*obb.Extents.X +
+		F32Abs(axes[1].Dot(axis))*obb.Extents.Y +
+		F32Abs(axes[2].Dot(axis))*obb.Extents.Z
+}
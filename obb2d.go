@@ -0,0 +1,58 @@
+package gmath
+
+// OBB2D oriented rectangle, rotated clockwise by Rotation around Center, see Vector2.Rotate
+type OBB2D struct {
+	Center   Vector2     `json:"center"`
+	Extents  Vector2     `json:"extents"`
+	Rotation AngleRadian `json:"rotation"`
+}
+
+func OBB2DFromRect(rect Rect) OBB2D {
+	return OBB2D{rect.Center(), rect.Size.Scale(0.5), 0}
+}
+
+// Corners clockwise
+func (obb OBB2D) Corners() [4]Vector2 {
+	x := Vector2{obb.Extents.X, 0}.Rotate(obb.Rotation)
+	y := Vector2{0, obb.Extents.Y}.Rotate(obb.Rotation)
+	left := obb.Center.Substract(x)
+	right := obb.Center.Add(x)
+	return [4]Vector2{
+		left.Substract(y),
+		left.Add(y),
+		right.Add(y),
+		right.Substract(y),
+	}
+}
+
+// ToLocal world point to the local space of the rectangle, it is RectCenterSize(V2Zero(), Extents*2) there
+func (obb OBB2D) ToLocal(point Vector2) Vector2 {
+	return point.Substract(obb.Center).Rotate(-obb.Rotation)
+}
+
+func (obb OBB2D) ToWorld(point Vector2) Vector2 {
+	return obb.Center.Add(point.Rotate(obb.Rotation))
+}
+
+func (obb OBB2D) Contains(point Vector2) bool {
+	local := obb.ToLocal(point)
+	return F32Abs(local.X) <= obb.Extents.X && F32Abs(local.Y) <= obb.Extents.Y
+}
+
+func (obb OBB2D) GetRect() Rect {
+	corners := obb.Corners()
+	return _V2RectFromPoints(corners[:])
+}
+
+func (obb OBB2D) Linecast(start Vector2, end Vector2) (RaycastHit2D, bool) {
+	return obb._Hull()._Linecast(start, end)
+}
+
+func (obb OBB2D) _Convexes() []_Convex2D {
+	return []_Convex2D{obb._Hull()}
+}
+
+func (obb OBB2D) _Hull() _Convex2D {
+	corners := obb.Corners()
+	return _Convex2D{corners[:], 0}
+}
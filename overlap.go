@@ -0,0 +1,134 @@
+package gmath
+
+// touching shapes are treated as overlapping
+
+func OverlapSphereSphere(a Sphere, b Sphere) bool {
+	radius := a.Radius + b.Radius
+	return V3DistanceSqr(a.Center, b.Center) <= radius*radius
+}
+
+func OverlapSphereBounds(sphere Sphere, bounds Bounds) bool {
+	return bounds.SqrDistance(sphere.Center) <= sphere.Radius*sphere.Radius
+}
+
+func OverlapSphereOBB(sphere Sphere, obb OBB) bool {
+	return obb.SqrDistance(sphere.Center) <= sphere.Radius*sphere.Radius
+}
+
+func OverlapSphereCapsule(sphere Sphere, capsule Capsule) bool {
+	segment := capsule.Segment()
+	closest := segment.GetPoint(_SegmentClosestT(segment, sphere.Center))
+	return OverlapSphereSphere(sphere, Sphere{closest, capsule.Radius})
+}
+
+func OverlapBoundsBounds(a Bounds, b Bounds) bool {
+	return a.Intersects(b)
+}
+
+func OverlapBoundsOBB(bounds Bounds, obb OBB) bool {
+	return OverlapOBBOBB(OBBFromBounds(bounds), obb)
+}
+
+func OverlapBoundsCapsule(bounds Bounds, capsule Capsule) bool {
+	return _SegmentBoundsSqrDistance(capsule.Segment(), bounds) <= capsule.Radius*capsule.Radius
+}
+
+// OverlapOBBOBB separating axis test, from Real-Time Collision Detection 4.4.1
+func OverlapOBBOBB(a OBB, b OBB) bool {
+	axesA := a.Axes()
+	axesB := b.Axes()
+	extentsA := [3]float32{a.Extents.X, a.Extents.Y, a.Extents.Z}
+	extentsB := [3]float32{b.Extents.X, b.Extents.Y, b.Extents.Z}
+
+	// rotation of b in the space of a, with epsilon to counteract parallel edges
+	var r, absR [3][3]float32
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = axesA[i].Dot(axesB[j])
+			absR[i][j] = F32Abs(r[i][j]) + Epsilon
+		}
+	}
+	offset := b.Center.Substract(a.Center)
+	t := [3]float32{offset.Dot(axesA[0]), offset.Dot(axesA[1]), offset.Dot(axesA[2])}
+
+	for i := 0; i < 3; i++ {
+		ra := extentsA[i]
+		rb := extentsB[0]*absR[i][0] + extentsB[1]*absR[i][1] + extentsB[2]*absR[i][2]
+		if F32Abs(t[i]) > ra+rb {
+			return false
+		}
+	}
+	for j := 0; j < 3; j++ {
+		ra := extentsA[0]*absR[0][j] + extentsA[1]*absR[1][j] + extentsA[2]*absR[2][j]
+		rb := extentsB[j]
+		if F32Abs(t[0]*r[0][j]+t[1]*r[1][j]+t[2]*r[2][j]) > ra+rb {
+			return false
+		}
+	}
+	for i := 0; i < 3; i++ {
+		i1, i2 := (i+1)%3, (i+2)%3
+		for j := 0; j < 3; j++ {
+			j1, j2 := (j+1)%3, (j+2)%3
+			ra := extentsA[i1]*absR[i2][j] + extentsA[i2]*absR[i1][j]
+			rb := extentsB[j1]*absR[i][j2] + extentsB[j2]*absR[i][j1]
+			if F32Abs(t[i2]*r[i1][j]-t[i1]*r[i2][j]) > ra+rb {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func OverlapOBBCapsule(obb OBB, capsule Capsule) bool {
+	local := Segment{obb.ToLocal(capsule.Start), obb.ToLocal(capsule.End)}
+	return _SegmentBoundsSqrDistance(local, obb._LocalBounds()) <= capsule.Radius*capsule.Radius
+}
+
+func OverlapCapsuleCapsule(a Capsule, b Capsule) bool {
+	segmentA := a.Segment()
+	segmentB := b.Segment()
+	s, t := _SegmentClosestParams(segmentA, segmentB)
+	return OverlapSphereSphere(Sphere{segmentA.GetPoint(s), a.Radius}, Sphere{segmentB.GetPoint(t), b.Radius})
+}
+
+func OverlapSphereTriangle(sphere Sphere, triangle Triangle) bool {
+	u, v := _TriangleClosestParams(triangle, sphere.Center)
+	return V3DistanceSqr(sphere.Center, triangle.GetPoint(u, v)) <= sphere.Radius*sphere.Radius
+}
+
+func OverlapCapsuleTriangle(capsule Capsule, triangle Triangle) bool {
+	distance, _, _, _, _, _ := ClosestPointsSegmentTriangle(capsule.Segment(), triangle)
+	return distance <= capsule.Radius
+}
+
+// OverlapBoundsTriangle separating axis test of Akenine-Moller
+func OverlapBoundsTriangle(bounds Bounds, triangle Triangle) bool {
+	v := [3]Vector3{
+		triangle.A.Substract(bounds.Center),
+		triangle.B.Substract(bounds.Center),
+		triangle.C.Substract(bounds.Center),
+	}
+	e := bounds.Extents
+	separated := func(axis Vector3) bool {
+		p0, p1, p2 := axis.Dot(v[0]), axis.Dot(v[1]), axis.Dot(v[2])
+		r := e.X*F32Abs(axis.X) + e.Y*F32Abs(axis.Y) + e.Z*F32Abs(axis.Z)
+		return F32Min(p0, F32Min(p1, p2)) > r || F32Max(p0, F32Max(p1, p2)) < -r
+	}
+
+	// the axes of the box
+	if separated(V3Right()) || separated(V3Up()) || separated(V3Forward()) {
+		return false
+	}
+	// the normal of the triangle
+	edges := [3]Vector3{v[1].Substract(v[0]), v[2].Substract(v[1]), v[0].Substract(v[2])}
+	if separated(edges[0].Cross(edges[1])) {
+		return false
+	}
+	// the cross products of the edges
+	for _, edge := range edges {
+		if separated(V3Right().Cross(edge)) || separated(V3Up().Cross(edge)) || separated(V3Forward().Cross(edge)) {
+			return false
+		}
+	}
+	return true
+}
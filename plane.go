@@ -0,0 +1,121 @@
+package gmath
+
+// Plane same as Unity's Plane, the points p on the plane: Normal.Dot(p) + Distance = 0
+type Plane struct {
+	Normal   Vector3 `json:"normal"`
+	Distance float32 `json:"distance"`
+}
+
+// PlaneSide the result of classifying a shape against a plane
+type PlaneSide int
+
+const (
+	// PlaneSideFront the shape is completely on the side the normal points to
+	PlaneSideFront PlaneSide = iota
+	// PlaneSideBack the shape is completely behind the plane
+	PlaneSideBack
+	// PlaneSideIntersect the shape crosses the plane
+	PlaneSideIntersect
+)
+
+// PlaneFromNormalPoint normal is normalized
+func PlaneFromNormalPoint(normal Vector3, point Vector3) Plane {
+	normal = normal.Normalize()
+	return Plane{normal, -normal.Dot(point)}
+}
+
+// PlaneFromPoints a, b, c are clockwise when seeing from the front, same as Unity
+func PlaneFromPoints(a, b, c Vector3) Plane {
+	return PlaneFromNormalPoint(b.Substract(a).Cross(c.Substract(a)), a)
+}
+
+func (plane Plane) Flip() Plane {
+	return Plane{plane.Normal.Scale(-1), -plane.Distance}
+}
+
+// GetDistanceToPoint signed, positive in front
+func (plane Plane) GetDistanceToPoint(point Vector3) float32 {
+	return plane.Normal.Dot(point) + plane.Distance
+}
+
+// GetSide true if the point is in front
+func (plane Plane) GetSide(point Vector3) bool {
+	return plane.GetDistanceToPoint(point) > 0
+}
+
+func (plane Plane) ClosestPointOnPlane(point Vector3) Vector3 {
+	return point.Substract(plane.Normal.Scale(plane.GetDistanceToPoint(point)))
+}
+
+// Transform the normal is transformed by the inverse transpose
+func (plane Plane) Transform(matrix *Matrix4) Plane {
+	point := matrix.MultiplyPoint3x4(plane.Normal.Scale(-plane.Distance))
+	normal := Matrix3Normal(matrix)
+	return PlaneFromNormalPoint(normal.MultiplyV3(plane.Normal), point)
+}
+
+func (plane Plane) Raycast(ray Ray) (RaycastHit, bool) {
+	denominator := plane.Normal.Dot(ray.Direction)
+	if F32IsZero2(denominator, 1e-7) {
+		return RaycastHit{}, false
+	}
+	distance := -plane.GetDistanceToPoint(ray.Origin) / denominator
+	if distance < 0 {
+		return RaycastHit{}, false
+	}
+	normal := plane.Normal
+	if denominator > 0 {
+		normal = normal.Scale(-1)
+	}
+	return _NewRaycastHit(ray, distance, normal), true
+}
+
+// _Classify by the signed distance of the center and the projected radius
+func (plane Plane) _Classify(center Vector3, radius float32) PlaneSide {
+	distance := plane.GetDistanceToPoint(center)
+	if distance > radius {
+		return PlaneSideFront
+	} else if distance < -radius {
+		return PlaneSideBack
+	}
+	return PlaneSideIntersect
+}
+
+func (plane Plane) ClassifyPoint(point Vector3) PlaneSide {
+	return plane._Classify(point, 0)
+}
+
+func (plane Plane) ClassifySphere(sphere Sphere) PlaneSide {
+	return plane._Classify(sphere.Center, sphere.Radius)
+}
+
+func (plane Plane) ClassifyBounds(bounds Bounds) PlaneSide {
+	n := plane.Normal
+	radius := F32Abs(n.X)*bounds.Extents.X + F32Abs(n.Y)*bounds.Extents.Y + F32Abs(n.Z)*bounds.Extents.Z
+	return plane._Classify(bounds.Center, radius)
+}
+
+func (plane Plane) ClassifyOBB(obb OBB) PlaneSide {
+	return plane._Classify(obb.Center, obb._ProjectedRadius(plane.Normal))
+}
+
+func (plane Plane) ClassifySegment(segment Segment) PlaneSide {
+	return plane._Classify(segment.Center(), F32Abs(plane.Normal.Dot(segment.End.Substract(segment.Start)))*0.5)
+}
+
+func (plane Plane) ClassifyCapsule(capsule Capsule) PlaneSide {
+	segment := capsule.Segment()
+	return plane._Classify(segment.Center(), F32Abs(plane.Normal.Dot(segment.End.Substract(segment.Start)))*0.5+capsule.Radius)
+}
+
+func (plane Plane) ClassifyTriangle(triangle Triangle) PlaneSide {
+	d0 := plane.GetDistanceToPoint(triangle.A)
+	d1 := plane.GetDistanceToPoint(triangle.B)
+	d2 := plane.GetDistanceToPoint(triangle.C)
+	if d0 > 0 && d1 > 0 && d2 > 0 {
+		return PlaneSideFront
+	} else if d0 < 0 && d1 < 0 && d2 < 0 {
+		return PlaneSideBack
+	}
+	return PlaneSideIntersect
+}
@@ -0,0 +1,148 @@
+package gmath
+
+import "sort"
+
+// Polygon a simple polygon, convex or concave, in either winding order
+type Polygon struct {
+	Points []Vector2 `json:"points"`
+}
+
+// SignedArea positive if clockwise
+func (polygon Polygon) SignedArea() float32 {
+	return _V2SignedArea(polygon.Points)
+}
+
+func (polygon Polygon) Area() float32 {
+	return F32Abs(polygon.SignedArea())
+}
+
+func (polygon Polygon) IsClockwise() bool {
+	return polygon.SignedArea() > 0
+}
+
+func (polygon Polygon) IsConvex() bool {
+	n := len(polygon.Points)
+	if n < 3 {
+		return false
+	}
+	var sign float32
+	for i := range polygon.Points {
+		a, b, c := polygon.Points[i], polygon.Points[(i+1)%n], polygon.Points[(i+2)%n]
+		cross := b.Substract(a).Cross(c.Substract(b))
+		if F32IsZero2(cross, 1e-12) {
+			continue
+		}
+		if sign == 0 {
+			sign = F32Sign(cross)
+		} else if F32Sign(cross) != sign {
+			return false
+		}
+	}
+	return true
+}
+
+func (polygon Polygon) Contains(point Vector2) bool {
+	return _V2PolygonContains(polygon.Points, point)
+}
+
+func (polygon Polygon) GetRect() Rect {
+	return _V2RectFromPoints(polygon.Points)
+}
+
+func (polygon Polygon) Linecast(start Vector2, end Vector2) (RaycastHit2D, bool) {
+	if len(polygon.Points) < 3 {
+		return RaycastHit2D{}, false
+	}
+	return _V2Linecast(polygon.Points, 0, polygon.Contains(start), start, end)
+}
+
+// ConvexHull monotone chain, the hull is clockwise
+func (polygon Polygon) ConvexHull() Polygon {
+	points := append([]Vector2(nil), polygon.Points...)
+	if len(points) < 3 {
+		return Polygon{points}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].X != points[j].X {
+			return points[i].X < points[j].X
+		}
+		return points[i].Y < points[j].Y
+	})
+
+	hull := make([]Vector2, 0, len(points)+1)
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, p := range points {
+			for len(hull) >= start+2 && hull[len(hull)-1].Substract(hull[len(hull)-2]).Cross(p.Substract(hull[len(hull)-1])) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		hull = hull[:len(hull)-1]
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+	return Polygon{hull}
+}
+
+// Triangulate ear clipping, returns the indices of the triangles
+func (polygon Polygon) Triangulate() [][3]int {
+	n := len(polygon.Points)
+	if n < 3 {
+		return nil
+	}
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	clockwise := polygon.IsClockwise()
+	triangles := make([][3]int, 0, n-2)
+	for len(indices) > 3 {
+		ear := 0
+		for i := range indices {
+			if polygon._IsEar(indices, i, clockwise) {
+				ear = i
+				break
+			}
+		}
+		prev := indices[(ear+len(indices)-1)%len(indices)]
+		next := indices[(ear+1)%len(indices)]
+		triangles = append(triangles, [3]int{prev, indices[ear], next})
+		indices = append(indices[:ear], indices[ear+1:]...)
+	}
+	return append(triangles, [3]int{indices[0], indices[1], indices[2]})
+}
+
+func (polygon Polygon) _IsEar(indices []int, i int, clockwise bool) bool {
+	n := len(indices)
+	a := polygon.Points[indices[(i+n-1)%n]]
+	b := polygon.Points[indices[i]]
+	c := polygon.Points[indices[(i+1)%n]]
+	cross := b.Substract(a).Cross(c.Substract(b))
+	if (cross > 0) != clockwise || F32IsZero2(cross, 1e-12) {
+		return false
+	}
+	triangle := []Vector2{a, b, c}
+	for j := 0; j < n; j++ {
+		if j == i || j == (i+n-1)%n || j == (i+1)%n {
+			continue
+		}
+		if _V2PolygonContains(triangle, polygon.Points[indices[j]]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (polygon Polygon) _Convexes() []_Convex2D {
+	if polygon.IsConvex() {
+		return []_Convex2D{{polygon.Points, 0}}
+	}
+	triangles := polygon.Triangulate()
+	convexes := make([]_Convex2D, len(triangles))
+	for i, t := range triangles {
+		convexes[i] = _Convex2D{[]Vector2{polygon.Points[t[0]], polygon.Points[t[1]], polygon.Points[t[2]]}, 0}
+	}
+	return convexes
+}
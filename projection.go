@@ -0,0 +1,125 @@
+package gmath
+
+// Handedness of the view space, the world space is always Unity's (left-handed)
+type Handedness int
+
+const (
+	// RightHanded the camera looks at -Z, Unity's Camera.worldToCameraMatrix and OpenGL
+	RightHanded Handedness = iota
+	// LeftHanded the camera looks at +Z, Direct3D
+	LeftHanded
+)
+
+// DepthRange of the clip space after perspective divide
+type DepthRange int
+
+const (
+	// DepthNegativeOneToOne near => -1, far => 1, OpenGL and Unity's Matrix4x4.Perspective
+	DepthNegativeOneToOne DepthRange = iota
+	// DepthZeroToOne near => 0, far => 1, Direct3D, Vulkan and Metal
+	DepthZeroToOne
+)
+
+func (handedness Handedness) _ForwardSign() float32 {
+	if handedness == LeftHanded {
+		return 1
+	}
+	return -1
+}
+
+// Matrix4Perspective same as Unity's Matrix4x4.Perspective, fov is vertical
+func Matrix4Perspective(fov AngleDegree, aspect, zNear, zFar float32) Matrix4 {
+	return Matrix4PerspectiveClip(fov, aspect, zNear, zFar, RightHanded, DepthNegativeOneToOne)
+}
+
+// Matrix4PerspectiveClip fov is vertical
+func Matrix4PerspectiveClip(fov AngleDegree, aspect, zNear, zFar float32, handedness Handedness, depth DepthRange) Matrix4 {
+	cot := 1 / Tan(fov.ToRadian().Multiply(0.5))
+
+	result := Matrix4{}
+	result.m00 = cot / aspect
+	result.m11 = cot
+	result.m22, result.m23 = _PerspectiveDepth(zNear, zFar, handedness, depth)
+	result.m32 = handedness._ForwardSign()
+	return result
+}
+
+// Matrix4Frustum same as Unity's Matrix4x4.Frustum
+func Matrix4Frustum(left, right, bottom, top, zNear, zFar float32) Matrix4 {
+	return Matrix4FrustumClip(left, right, bottom, top, zNear, zFar, RightHanded, DepthNegativeOneToOne)
+}
+
+// Matrix4FrustumClip left, right, bottom and top are on the near plane
+func Matrix4FrustumClip(left, right, bottom, top, zNear, zFar float32, handedness Handedness, depth DepthRange) Matrix4 {
+	sign := handedness._ForwardSign()
+
+	result := Matrix4{}
+	result.m00 = 2 * zNear / (right - left)
+	result.m02 = -sign * (right + left) / (right - left)
+	result.m11 = 2 * zNear / (top - bottom)
+	result.m12 = -sign * (top + bottom) / (top - bottom)
+	result.m22, result.m23 = _PerspectiveDepth(zNear, zFar, handedness, depth)
+	result.m32 = sign
+	return result
+}
+
+// Matrix4Ortho same as Unity's Matrix4x4.Ortho
+func Matrix4Ortho(left, right, bottom, top, zNear, zFar float32) Matrix4 {
+	return Matrix4OrthoClip(left, right, bottom, top, zNear, zFar, RightHanded, DepthNegativeOneToOne)
+}
+
+func Matrix4OrthoClip(left, right, bottom, top, zNear, zFar float32, handedness Handedness, depth DepthRange) Matrix4 {
+	sign := handedness._ForwardSign()
+
+	result := Matrix4{}
+	result.m00 = 2 / (right - left)
+	result.m03 = -(right + left) / (right - left)
+	result.m11 = 2 / (top - bottom)
+	result.m13 = -(top + bottom) / (top - bottom)
+	if depth == DepthZeroToOne {
+		result.m22 = sign / (zFar - zNear)
+		result.m23 = -zNear / (zFar - zNear)
+	} else {
+		result.m22 = sign * 2 / (zFar - zNear)
+		result.m23 = -(zFar + zNear) / (zFar - zNear)
+	}
+	result.m33 = 1
+	return result
+}
+
+// _PerspectiveDepth return m22, m23
+func _PerspectiveDepth(zNear, zFar float32, handedness Handedness, depth DepthRange) (float32, float32) {
+	sign := handedness._ForwardSign()
+	if depth == DepthZeroToOne {
+		return sign * zFar / (zFar - zNear), -zFar * zNear / (zFar - zNear)
+	}
+	return sign * (zFar + zNear) / (zFar - zNear), -2 * zFar * zNear / (zFar - zNear)
+}
+
+// Matrix4LookAt same as Unity's Matrix4x4.LookAt, the local to world matrix of an object at from looking at to
+func Matrix4LookAt(from, to, up Vector3) Matrix4 {
+	forward := to.Substract(from)
+	if forward.NormalizeSelf() == 0 {
+		forward = V3Forward()
+	}
+	right := up.Cross(forward)
+	if right.NormalizeSelf() == 0 {
+		right = _V3AnyPerpendicular(forward)
+	}
+	newUp := forward.Cross(right)
+
+	return Matrix4FromColumns(V4FromDirection(right), V4FromDirection(newUp), V4FromDirection(forward), V4FromPoint(from))
+}
+
+// Matrix4LookAtView the world to view matrix of a camera at eye looking at target
+func Matrix4LookAtView(eye, target, up Vector3, handedness Handedness) Matrix4 {
+	result := Matrix4LookAt(eye, target, up)
+	result = result.Inverse()
+	if handedness == RightHanded {
+		result.m20 = -result.m20
+		result.m21 = -result.m21
+		result.m22 = -result.m22
+		result.m23 = -result.m23
+	}
+	return result
+}
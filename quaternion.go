@@ -2,6 +2,7 @@ package gmath
 
 import (
 	"math"
+	"sort"
 )
 
 const (
@@ -139,6 +140,28 @@ func (q Quaternion) ToEulerAngle() Vector3 {
 	}
 }
 
+// ToAngleAxis the inverse of QuaternionAngleAxis, angle [0,PI], axis is normalized, V3Right() if angle is 0
+func (q Quaternion) ToAngleAxis() (AngleRadian, Vector3) {
+	q.NormalizeSelf()
+	if q.W < 0 {
+		q = Quaternion{-q.X, -q.Y, -q.Z, -q.W}
+	}
+
+	axis := Vector3{q.X, q.Y, q.Z}
+	sinValue := axis.Magnitude()
+	if F32IsZero2(sinValue, 1e-7) {
+		return 0, V3Right()
+	}
+	angle := Atan2(sinValue, q.W) * 2
+	return angle, axis.Scale(1 / sinValue)
+}
+
+// ToRotationVector axis * angle in radian, [0,PI]
+func (q Quaternion) ToRotationVector() Vector3 {
+	angle, axis := q.ToAngleAxis()
+	return axis.Scale(angle.ToFloat32())
+}
+
 func QuaternionAngle(a, b Quaternion) AngleDegree {
 	num := F32Min(F32Abs(a.Dot(b)), 1)
 	if num > 0.999999 {
@@ -170,54 +193,36 @@ func QuaternionLookRotation(forward Vector3, up Vector3) Quaternion {
 	vector2.NormalizeSelf()
 	vector3 := vector.Cross(vector2)
 
-	m00 := vector2.X
-	m01 := vector2.Y
-	m02 := vector2.Z
-	m10 := vector3.X
-	m11 := vector3.Y
-	m12 := vector3.Z
-	m20 := vector.X
-	m21 := vector.Y
-	m22 := vector.Z
-
-	num8 := m00 + m11 + m22
-	quaternion := Quaternion{}
-	if num8 > 0 {
-		num := float32(math.Sqrt(float64(num8 + 1.0)))
-		quaternion.W = float32(num * 0.5)
-		num = 0.5 / num
-		quaternion.X = (m12 - m21) * num
-		quaternion.Y = (m20 - m02) * num
-		quaternion.Z = (m01 - m10) * num
-		return quaternion
-	}
-	if m00 >= m11 && m00 >= m22 {
-		num7 := float32(math.Sqrt(float64(1.0 + m00 - m11 - m22)))
-		num4 := 0.5 / num7
-		quaternion.X = 0.5 * num7
-		quaternion.Y = (m01 + m10) * num4
-		quaternion.Z = (m02 + m20) * num4
-		quaternion.W = (m12 - m21) * num4
-		return quaternion
-	}
-	if m11 > m22 {
-		num6 := float32(math.Sqrt(float64(1.0 + m11 - m00 - m22)))
-		num3 := 0.5 / num6
-		quaternion.X = (m10 + m01) * num3
-		quaternion.Y = 0.5 * num6
-		quaternion.Z = (m21 + m12) * num3
-		quaternion.W = (m20 - m02) * num3
-		return quaternion
-	}
+	return _QuaternionFromMatrix(
+		vector2.X, vector3.X, vector.X,
+		vector2.Y, vector3.Y, vector.Y,
+		vector2.Z, vector3.Z, vector.Z)
+}
 
-	num5 := float32(math.Sqrt(float64(1.0 + m22 - m00 - m11)))
-	num2 := 0.5 / num5
-	quaternion.X = (m20 + m02) * num2
-	quaternion.Y = (m21 + m12) * num2
-	quaternion.Z = 0.5 * num5
-	quaternion.W = (m01 - m10) * num2
-	return quaternion
+// QuaternionFromMatrix the upper-left 3x3 must be a pure rotation, use Matrix4.GetRotation if it is scaled
+func QuaternionFromMatrix(m *Matrix4) Quaternion {
+	ret := _QuaternionFromMatrix(
+		m.m00, m.m01, m.m02,
+		m.m10, m.m11, m.m12,
+		m.m20, m.m21, m.m22)
+	ret.NormalizeSelf()
+	return ret
+}
+
+// QuaternionFromMatrix3 the matrix must be a pure rotation
+func QuaternionFromMatrix3(m *Matrix3) Quaternion {
+	ret := m.ToQuaternion()
+	ret.NormalizeSelf()
+	return ret
+}
 
+// QuaternionFromRotationVector the direction is the axis, the magnitude is the angle in radian
+func QuaternionFromRotationVector(v Vector3) Quaternion {
+	angle := v.Magnitude()
+	if F32IsZero2(angle, 1e-7) {
+		return QuaternionIdentity()
+	}
+	return QuaternionAngleAxis(AngleRadian(angle), v)
 }
 
 func QuaternionFromTo(fromVector, toVector Vector3) Quaternion {
@@ -303,3 +308,230 @@ func QuaternionSlerpUnclamped(from Quaternion, to Quaternion, t float32) Quatern
 		W: from.W*c1 + to.W*c2,
 	}
 }
+
+// QuaternionSmoothDamp smooth damp every component by the shortest path, currentVelocity is updated in place
+func QuaternionSmoothDamp(current Quaternion, target Quaternion, currentVelocity *Vector4, smoothTime float32, maxSpeed float32, deltaTime float32) Quaternion {
+	if deltaTime <= 0 {
+		return current
+	}
+	if current.Dot(target) < 0 {
+		target = Quaternion{-target.X, -target.Y, -target.Z, -target.W}
+	}
+
+	ret := Quaternion{
+		X: F32SmoothDamp(current.X, target.X, &currentVelocity.X, smoothTime, maxSpeed, deltaTime),
+		Y: F32SmoothDamp(current.Y, target.Y, &currentVelocity.Y, smoothTime, maxSpeed, deltaTime),
+		Z: F32SmoothDamp(current.Z, target.Z, &currentVelocity.Z, smoothTime, maxSpeed, deltaTime),
+		W: F32SmoothDamp(current.W, target.W, &currentVelocity.W, smoothTime, maxSpeed, deltaTime),
+	}
+	ret.NormalizeSelf()
+
+	// keep the velocity tangent to the unit sphere
+	v4 := Vector4{ret.X, ret.Y, ret.Z, ret.W}
+	*currentVelocity = currentVelocity.Substract(v4.Scale(currentVelocity.Dot(v4)))
+	return ret
+}
+
+// QuaternionDamp exponential decay towards target
+func QuaternionDamp(current Quaternion, target Quaternion, halfLife float32, deltaTime float32) Quaternion {
+	return QuaternionSlerpUnclamped(current, target, F32DampFactor(halfLife, deltaTime))
+}
+
+// _QuaternionFromMatrix Shepperd's method, mRC is row R column C of a rotation matrix
+func _QuaternionFromMatrix(m00, m01, m02, m10, m11, m12, m20, m21, m22 float32) Quaternion {
+	quaternion := Quaternion{}
+	trace := m00 + m11 + m22
+	if trace > 0 {
+		num := F32Sqrt(trace + 1.0)
+		quaternion.W = num * 0.5
+		num = 0.5 / num
+		quaternion.X = (m21 - m12) * num
+		quaternion.Y = (m02 - m20) * num
+		quaternion.Z = (m10 - m01) * num
+		return quaternion
+	}
+	if m00 >= m11 && m00 >= m22 {
+		num := F32Sqrt(1.0 + m00 - m11 - m22)
+		quaternion.X = 0.5 * num
+		num = 0.5 / num
+		quaternion.Y = (m10 + m01) * num
+		quaternion.Z = (m20 + m02) * num
+		quaternion.W = (m21 - m12) * num
+		return quaternion
+	}
+	if m11 > m22 {
+		num := F32Sqrt(1.0 + m11 - m00 - m22)
+		quaternion.Y = 0.5 * num
+		num = 0.5 / num
+		quaternion.X = (m01 + m10) * num
+		quaternion.Z = (m12 + m21) * num
+		quaternion.W = (m02 - m20) * num
+		return quaternion
+	}
+	num := F32Sqrt(1.0 + m22 - m00 - m11)
+	quaternion.Z = 0.5 * num
+	num = 0.5 / num
+	quaternion.X = (m02 + m20) * num
+	quaternion.Y = (m12 + m21) * num
+	quaternion.W = (m10 - m01) * num
+	return quaternion
+}
+
+// Log (axis * halfAngle, ln|q|)
+func (q Quaternion) Log() Quaternion {
+	v := Vector3{q.X, q.Y, q.Z}
+	sinValue := v.Magnitude()
+	magnitude := F32Sqrt(sinValue*sinValue + q.W*q.W)
+	w := float32(math.Log(float64(magnitude)))
+	if F32IsZero2(sinValue, 1e-7) {
+		return Quaternion{0, 0, 0, w}
+	}
+	v = v.Scale(Atan2(sinValue, q.W).ToFloat32() / sinValue)
+	return Quaternion{v.X, v.Y, v.Z, w}
+}
+
+// Exp the inverse of Log
+func (q Quaternion) Exp() Quaternion {
+	v := Vector3{q.X, q.Y, q.Z}
+	angle := v.Magnitude()
+	magnitude := float32(math.Exp(float64(q.W)))
+	if F32IsZero2(angle, 1e-7) {
+		return Quaternion{v.X * magnitude, v.Y * magnitude, v.Z * magnitude, magnitude}
+	}
+	v = v.Scale(Sin(AngleRadian(angle)) * magnitude / angle)
+	return Quaternion{v.X, v.Y, v.Z, Cos(AngleRadian(angle)) * magnitude}
+}
+
+// Pow rotate t times the angle about the same axis, q must be normalized
+func (q Quaternion) Pow(t float32) Quaternion {
+	l := q.Log()
+	return Quaternion{l.X * t, l.Y * t, l.Z * t, l.W * t}.Exp()
+}
+
+// QuaternionSquad spherical quadrangle interpolation from q0 to q1, t [0,1]
+// a0 and a1 are the control points from QuaternionSquadIntermediate
+func QuaternionSquad(q0, a0, a1, q1 Quaternion, t float32) Quaternion {
+	t = F32Clamp01(t)
+	slerp0 := _QuaternionSlerpNoInvert(q0, q1, t)
+	slerp1 := _QuaternionSlerpNoInvert(a0, a1, t)
+	return _QuaternionSlerpNoInvert(slerp0, slerp1, 2*t*(1-t))
+}
+
+// QuaternionSquadIntermediate the control point at current, for a C1 path through prev, current and next
+func QuaternionSquadIntermediate(prev, current, next Quaternion) Quaternion {
+	prev = _QuaternionAlign(prev, current)
+	next = _QuaternionAlign(next, current)
+
+	inv := current.Conjugate()
+	l0 := inv.Multiply(next).Log()
+	l1 := inv.Multiply(prev).Log()
+	sum := Quaternion{
+		X: -(l0.X + l1.X) * 0.25,
+		Y: -(l0.Y + l1.Y) * 0.25,
+		Z: -(l0.Z + l1.Z) * 0.25,
+	}
+	ret := current.Multiply(sum.Exp())
+	ret.NormalizeSelf()
+	return ret
+}
+
+type QuaternionKeyframe struct {
+	Time  float32    `json:"time"`
+	Value Quaternion `json:"value"`
+}
+
+// QuaternionEvaluateKeyframes SQUAD through keys sorted by Time, C1 for any spacing of the keys
+// return identity if keys is empty, clamped to the first and the last key
+func QuaternionEvaluateKeyframes(keys []QuaternionKeyframe, time float32) Quaternion {
+	count := len(keys)
+	if count == 0 {
+		return QuaternionIdentity()
+	}
+	if time <= keys[0].Time {
+		return keys[0].Value
+	}
+	if time >= keys[count-1].Time {
+		return keys[count-1].Value
+	}
+
+	index := sort.Search(count, func(i int) bool { return keys[i].Time > time }) - 1
+	q1 := keys[index].Value
+	q2 := _QuaternionAlign(keys[index+1].Value, q1)
+	span := keys[index+1].Time - keys[index].Time
+	if span <= 0 {
+		return q2
+	}
+
+	q0, q3 := q1, q2
+	span0, span2 := span, span
+	if index > 0 {
+		q0 = _QuaternionAlign(keys[index-1].Value, q1)
+		if d := keys[index].Time - keys[index-1].Time; d > 0 {
+			span0 = d
+		}
+	}
+	if index+2 < count {
+		q3 = _QuaternionAlign(keys[index+2].Value, q2)
+		if d := keys[index+2].Time - keys[index+1].Time; d > 0 {
+			span2 = d
+		}
+	}
+
+	_, a1 := _QuaternionSquadIntermediates(q0, q1, q2, span0, span)
+	a2, _ := _QuaternionSquadIntermediates(q1, q2, q3, span, span2)
+	return QuaternionSquad(q1, a1, a2, q2, (time-keys[index].Time)/span)
+}
+
+// _QuaternionSquadIntermediates the control points at current for the incoming and the outgoing segment,
+// the tangents are scaled by the key intervals so the angular velocity is continuous, same as
+// QuaternionSquadIntermediate if the intervals are equal
+func _QuaternionSquadIntermediates(prev, current, next Quaternion, prevSpan, nextSpan float32) (Quaternion, Quaternion) {
+	prev = _QuaternionAlign(prev, current)
+	next = _QuaternionAlign(next, current)
+
+	inv := current.Conjugate()
+	l0 := inv.Multiply(next).Log()
+	l1 := inv.Multiply(prev).Log()
+
+	// the velocity by time at current, the squad velocity by t is l0+2*log(a) leaving and -l1-2*log(a) arriving
+	velocity := Vector3{l0.X - l1.X, l0.Y - l1.Y, l0.Z - l1.Z}.Scale(1 / (prevSpan + nextSpan))
+	in := velocity.Scale(prevSpan)
+	out := velocity.Scale(nextSpan)
+	incoming := current.Multiply(Quaternion{X: -(in.X + l1.X) * 0.5, Y: -(in.Y + l1.Y) * 0.5, Z: -(in.Z + l1.Z) * 0.5}.Exp())
+	outgoing := current.Multiply(Quaternion{X: (out.X - l0.X) * 0.5, Y: (out.Y - l0.Y) * 0.5, Z: (out.Z - l0.Z) * 0.5}.Exp())
+	incoming.NormalizeSelf()
+	outgoing.NormalizeSelf()
+	return incoming, outgoing
+}
+
+// _QuaternionAlign q or -q, whichever is closer to target
+func _QuaternionAlign(q, target Quaternion) Quaternion {
+	if q.Dot(target) < 0 {
+		return Quaternion{-q.X, -q.Y, -q.Z, -q.W}
+	}
+	return q
+}
+
+// _QuaternionSlerpNoInvert not the shortest path, used by SQUAD
+func _QuaternionSlerpNoInvert(from Quaternion, to Quaternion, t float32) Quaternion {
+	cos_theta := F32Clamp(from.Dot(to), -1, 1)
+
+	var c1, c2 float32
+	if F32Abs(cos_theta) > 1-_QuaternionEpsilon {
+		c2 = t
+		c1 = 1 - t
+	} else {
+		theta := Acos(cos_theta)
+		inv_sin_theta := 1 / Sin(theta)
+		t_theta := theta.Multiply(t)
+		c2 = Sin(t_theta) * inv_sin_theta
+		c1 = Sin(theta-t_theta) * inv_sin_theta
+	}
+
+	return Quaternion{
+		X: from.X*c1 + to.X*c2,
+		Y: from.Y*c1 + to.Y*c2,
+		Z: from.Z*c1 + to.Z*c2,
+		W: from.W*c1 + to.W*c2,
+	}
+}
@@ -24,4 +24,66 @@ func TestQuaternion(t *testing.T) {
 	if degree != 35.817104 {
 		t.Error("QuaternionAngle")
 	}
+
+	for _, q := range []Quaternion{
+		eulerQuaternion,
+		QuaternionAngleAxis(PI, V3Right()),
+		QuaternionAngleAxis(PI, V3Up()),
+		QuaternionAngleAxis(PI, Vector3{1, 1, 1}),
+		QuaternionAngleAxis(3, Vector3{-1, 2, 0.5}),
+	} {
+		m := Matrix4FromRotation(q)
+		if QuaternionAngle(QuaternionFromMatrix(&m), q) > 0.01 {
+			t.Error("QuaternionFromMatrix", q)
+		}
+
+		angle, axis := q.ToAngleAxis()
+		if QuaternionAngle(QuaternionAngleAxis(angle, axis), q) > 0.01 || !F32Equal(axis.Magnitude(), 1) || angle < 0 || angle > PI {
+			t.Error("ToAngleAxis", q)
+		}
+		if QuaternionAngle(QuaternionFromRotationVector(q.ToRotationVector()), q) > 0.01 {
+			t.Error("ToRotationVector", q)
+		}
+	}
+
+	angle, axis := QuaternionAngleAxis(AngleDegree(30).ToRadian(), V3Down()).ToAngleAxis()
+	if !F32Equal(angle.ToDegrees().ToFloat32(), 30) || !axis.Equal(V3Down()) {
+		t.Error("ToAngleAxis")
+	}
+}
+
+func TestQuaternionSquad(t *testing.T) {
+	q := QuaternionFromEulerAngle(Vector3{10, 20, 30})
+	if !q.Log().Exp().Equal(q) {
+		t.Error("Log Exp")
+	}
+	half := q.Pow(0.5)
+	if !half.Multiply(half).Equal(q) || !half.Equal(QuaternionSlerp(QuaternionIdentity(), q, 0.5)) {
+		t.Error("Pow")
+	}
+
+	for _, times := range [][4]float32{{0, 1, 2, 3}, {0, 0.3, 2, 2.5}} {
+		keys := []QuaternionKeyframe{
+			{times[0], QuaternionIdentity()},
+			{times[1], QuaternionFromEulerAngle(Vector3{0, 90, 0})},
+			{times[2], QuaternionFromEulerAngle(Vector3{45, 90, 0})},
+			{times[3], QuaternionFromEulerAngle(Vector3{45, 200, 30})},
+		}
+		for _, key := range keys {
+			if QuaternionAngle(QuaternionEvaluateKeyframes(keys, key.Time), key.Value) > 0.01 {
+				t.Error("QuaternionEvaluateKeyframes key")
+			}
+		}
+
+		// angular velocity is continuous at the keys
+		const dt = 1e-3
+		for i := 1; i < len(keys)-1; i++ {
+			time := keys[i].Time
+			before := QuaternionEvaluateKeyframes(keys, time-dt).Conjugate().Multiply(keys[i].Value)
+			after := keys[i].Value.Conjugate().Multiply(QuaternionEvaluateKeyframes(keys, time+dt))
+			if V3Distance(before.ToRotationVector(), after.ToRotationVector()) > dt*0.1 {
+				t.Error("QuaternionEvaluateKeyframes C1")
+			}
+		}
+	}
 }
@@ -0,0 +1,31 @@
+package gmath
+
+type Ray struct {
+	Origin    Vector3 `json:"origin"`
+	Direction Vector3 `json:"direction"`
+}
+
+// RaycastHit the normal faces against the ray
+type RaycastHit struct {
+	Distance float32 `json:"distance"`
+	Point    Vector3 `json:"point"`
+	Normal   Vector3 `json:"normal"`
+}
+
+// NewRay direction is normalized
+func NewRay(origin Vector3, direction Vector3) Ray {
+	return Ray{origin, direction.Normalize()}
+}
+
+func (ray Ray) GetPoint(distance float32) Vector3 {
+	return ray.Origin.Add(ray.Direction.Scale(distance))
+}
+
+// Transform the direction is normalized again, so distances are in the new space
+func (ray Ray) Transform(matrix *Matrix4) Ray {
+	return NewRay(matrix.MultiplyPoint3x4(ray.Origin), matrix.MultiplyDir3(ray.Direction))
+}
+
+func _NewRaycastHit(ray Ray, distance float32, normal Vector3) RaycastHit {
+	return RaycastHit{distance, ray.GetPoint(distance), normal}
+}
@@ -0,0 +1,28 @@
+package gmath
+
+type Ray2D struct {
+	Origin    Vector2 `json:"origin"`
+	Direction Vector2 `json:"direction"`
+}
+
+// RaycastHit2D Fraction is [0,1] along the cast, the normal faces against the cast
+type RaycastHit2D struct {
+	Point    Vector2 `json:"point"`
+	Normal   Vector2 `json:"normal"`
+	Fraction float32 `json:"fraction"`
+	Distance float32 `json:"distance"`
+}
+
+// NewRay2D direction is normalized
+func NewRay2D(origin Vector2, direction Vector2) Ray2D {
+	return Ray2D{origin, direction.Normalize()}
+}
+
+func (ray Ray2D) GetPoint(distance float32) Vector2 {
+	return ray.Origin.Add(ray.Direction.Scale(distance))
+}
+
+// Raycast2D cast the ray maxDistance against the shape, same as shape.Linecast(ray.Origin, ray.GetPoint(maxDistance))
+func Raycast2D(ray Ray2D, maxDistance float32, shape Shape2D) (RaycastHit2D, bool) {
+	return shape.Linecast(ray.Origin, ray.GetPoint(maxDistance))
+}
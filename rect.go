@@ -0,0 +1,76 @@
+package gmath
+
+// Rect the area in [Position, Position+Size], same as Unity's Rect
+type Rect struct {
+	Position Vector2 `json:"position"`
+	Size     Vector2 `json:"size"`
+}
+
+func RectMinMax(min, max Vector2) Rect {
+	return Rect{min, max.Substract(min)}
+}
+
+func RectCenterSize(center, size Vector2) Rect {
+	return Rect{center.Substract(size.Scale(0.5)), size}
+}
+
+func (rect Rect) Min() Vector2 {
+	return rect.Position
+}
+
+func (rect Rect) Max() Vector2 {
+	return rect.Position.Add(rect.Size)
+}
+
+func (rect Rect) Center() Vector2 {
+	return rect.Position.Add(rect.Size.Scale(0.5))
+}
+
+// Corners clockwise from Min
+func (rect Rect) Corners() [4]Vector2 {
+	min, max := rect.Min(), rect.Max()
+	return [4]Vector2{min, {min.X, max.Y}, max, {max.X, min.Y}}
+}
+
+func (rect Rect) Contains(point Vector2) bool {
+	min, max := rect.Min(), rect.Max()
+	return point.X >= min.X && point.X <= max.X && point.Y >= min.Y && point.Y <= max.Y
+}
+
+// Overlaps touching counts
+func (rect Rect) Overlaps(other Rect) bool {
+	min, max := rect.Min(), rect.Max()
+	otherMin, otherMax := other.Min(), other.Max()
+	return min.X <= otherMax.X && otherMin.X <= max.X && min.Y <= otherMax.Y && otherMin.Y <= max.Y
+}
+
+func (rect Rect) Encapsulate(point Vector2) Rect {
+	return RectMinMax(V2Min(rect.Min(), point), V2Max(rect.Max(), point))
+}
+
+func (rect Rect) EncapsulateRect(other Rect) Rect {
+	return RectMinMax(V2Min(rect.Min(), other.Min()), V2Max(rect.Max(), other.Max()))
+}
+
+func (rect Rect) ClosestPoint(point Vector2) Vector2 {
+	min, max := rect.Min(), rect.Max()
+	return Vector2{F32Clamp(point.X, min.X, max.X), F32Clamp(point.Y, min.Y, max.Y)}
+}
+
+func (rect Rect) GetRect() Rect {
+	return rect
+}
+
+func (rect Rect) Linecast(start Vector2, end Vector2) (RaycastHit2D, bool) {
+	corners := rect.Corners()
+	return _Convex2D{corners[:], 0}._Linecast(start, end)
+}
+
+func (rect Rect) _Convexes() []_Convex2D {
+	return []_Convex2D{rect._Hull()}
+}
+
+func (rect Rect) _Hull() _Convex2D {
+	corners := rect.Corners()
+	return _Convex2D{corners[:], 0}
+}
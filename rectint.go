@@ -0,0 +1,84 @@
+package gmath
+
+import "image"
+
+// RectInt cells in [Position, Position+Size)
+type RectInt struct {
+	Position Vector2Int `json:"position"`
+	Size     Vector2Int `json:"size"`
+}
+
+// RectIntMinMax max is exclusive
+func RectIntMinMax(min, max Vector2Int) RectInt {
+	return RectInt{min, max.Substract(min)}
+}
+
+func RectIntFromRectangle(r image.Rectangle) RectInt {
+	return RectIntMinMax(V2IntFromPoint(r.Min), V2IntFromPoint(r.Max))
+}
+
+func (rect RectInt) ToRectangle() image.Rectangle {
+	return image.Rectangle{rect.Min().ToPoint(), rect.Max().ToPoint()}
+}
+
+func (rect RectInt) Min() Vector2Int {
+	return rect.Position
+}
+
+// Max exclusive
+func (rect RectInt) Max() Vector2Int {
+	return rect.Position.Add(rect.Size)
+}
+
+func (rect RectInt) IsEmpty() bool {
+	return rect.Size.X <= 0 || rect.Size.Y <= 0
+}
+
+func (rect RectInt) Area() int {
+	if rect.IsEmpty() {
+		return 0
+	}
+	return rect.Size.X * rect.Size.Y
+}
+
+func (rect RectInt) Contains(cell Vector2Int) bool {
+	max := rect.Max()
+	return cell.X >= rect.Position.X && cell.X < max.X &&
+		cell.Y >= rect.Position.Y && cell.Y < max.Y
+}
+
+func (rect RectInt) Overlaps(other RectInt) bool {
+	_, ok := rect.Intersect(other)
+	return ok
+}
+
+// Intersect return false if there is no common cell
+func (rect RectInt) Intersect(other RectInt) (RectInt, bool) {
+	min := V2IntMax(rect.Min(), other.Min())
+	max := V2IntMin(rect.Max(), other.Max())
+	if min.X >= max.X || min.Y >= max.Y {
+		return RectInt{}, false
+	}
+	return RectIntMinMax(min, max), true
+}
+
+// ClampCell the nearest cell inside rect, rect must not be empty
+func (rect RectInt) ClampCell(cell Vector2Int) Vector2Int {
+	max := rect.Max()
+	return Vector2Int{
+		X: _IntMax(rect.Position.X, _IntMin(cell.X, max.X-1)),
+		Y: _IntMax(rect.Position.Y, _IntMin(cell.Y, max.Y-1)),
+	}
+}
+
+// ForEach row by row, stop if fn return false
+func (rect RectInt) ForEach(fn func(cell Vector2Int) bool) {
+	max := rect.Max()
+	for y := rect.Position.Y; y < max.Y; y++ {
+		for x := rect.Position.X; x < max.X; x++ {
+			if !fn(Vector2Int{x, y}) {
+				return
+			}
+		}
+	}
+}
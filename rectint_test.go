@@ -0,0 +1,61 @@
+package gmath
+
+import (
+	"image"
+	"testing"
+)
+
+func TestRectInt(t *testing.T) {
+	if V2FloorToInt(Vector2{-0.5, 1.5}) != (Vector2Int{-1, 1}) {
+		t.Error("V2FloorToInt")
+	}
+	if V3CeilToInt(Vector3{-0.5, 1.5, 2}) != (Vector3Int{0, 2, 2}) {
+		t.Error("V3CeilToInt")
+	}
+	if V2IntChebyshevDistance(Vector2Int{1, 1}, Vector2Int{4, -1}) != 3 {
+		t.Error("V2IntChebyshevDistance")
+	}
+	if V3IntManhattanDistance(Vector3Int{1, 1, 1}, Vector3Int{4, -1, 0}) != 6 {
+		t.Error("V3IntManhattanDistance")
+	}
+
+	r1 := RectIntMinMax(Vector2Int{0, 0}, Vector2Int{4, 4})
+	r2 := RectIntMinMax(Vector2Int{2, 3}, Vector2Int{6, 8})
+	r3, ok := r1.Intersect(r2)
+	if !ok || r3 != RectIntMinMax(Vector2Int{2, 3}, Vector2Int{4, 4}) {
+		t.Error("RectInt.Intersect")
+	}
+	if r1.Contains(Vector2Int{4, 0}) || !r1.Contains(Vector2Int{3, 3}) {
+		t.Error("RectInt.Contains")
+	}
+	if RectIntFromRectangle(r2.ToRectangle()) != r2 || r2.ToRectangle() != image.Rect(2, 3, 6, 8) {
+		t.Error("RectInt.ToRectangle")
+	}
+
+	count := 0
+	r1.ForEach(func(cell Vector2Int) bool {
+		if !r1.Contains(cell) {
+			t.Error("RectInt.ForEach")
+		}
+		count++
+		return true
+	})
+	if count != r1.Area() {
+		t.Error("RectInt.ForEach")
+	}
+
+	b := BoundsIntMinMax(Vector3Int{-1, -1, -1}, Vector3Int{2, 2, 2})
+	count = 0
+	b.ForEach(func(cell Vector3Int) bool {
+		count++
+		return true
+	})
+	if count != 27 || b.Volume() != 27 {
+		t.Error("BoundsInt.ForEach")
+	}
+	for _, n := range V3IntZero().Neighbors26() {
+		if !b.Contains(n) || n.IsZero() {
+			t.Error("Neighbors26")
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package gmath
+
+type Segment struct {
+	Start Vector3 `json:"start"`
+	End   Vector3 `json:"end"`
+}
+
+func (segment Segment) Center() Vector3 {
+	return V3LerpUnclamped(segment.Start, segment.End, 0.5)
+}
+
+func (segment Segment) Direction() Vector3 {
+	return segment.End.Substract(segment.Start)
+}
+
+func (segment Segment) Length() float32 {
+	return V3Distance(segment.Start, segment.End)
+}
+
+// GetPoint t is [0,1] from Start to End
+func (segment Segment) GetPoint(t float32) Vector3 {
+	return V3LerpUnclamped(segment.Start, segment.End, t)
+}
+
+func (segment Segment) GetBounds() Bounds {
+	return BoundsMinMax(V3Min(segment.Start, segment.End), V3Max(segment.Start, segment.End))
+}
+
+func (segment Segment) Transform(matrix *Matrix4) Segment {
+	return Segment{matrix.MultiplyPoint3x4(segment.Start), matrix.MultiplyPoint3x4(segment.End)}
+}
+
+// _SegmentClosestT the parameter of the closest point to point, 0 for degenerate segment
+func _SegmentClosestT(segment Segment, point Vector3) float32 {
+	dir := segment.Direction()
+	sqrLength := dir.SqrMagnitude()
+	if sqrLength < Epsilon*Epsilon {
+		return 0
+	}
+	return F32Clamp01(point.Substract(segment.Start).Dot(dir) / sqrLength)
+}
+
+// _SegmentClosestParams closest points of two segments, from Real-Time Collision Detection 5.1.9
+func _SegmentClosestParams(a Segment, b Segment) (s float32, t float32) {
+	d1 := a.Direction()
+	d2 := b.Direction()
+	r := a.Start.Substract(b.Start)
+	sqrLength1 := d1.SqrMagnitude()
+	sqrLength2 := d2.SqrMagnitude()
+	f := d2.Dot(r)
+
+	const epsilon = Epsilon * Epsilon
+	if sqrLength1 <= epsilon && sqrLength2 <= epsilon {
+		return 0, 0
+	}
+	if sqrLength1 <= epsilon {
+		return 0, F32Clamp01(f / sqrLength2)
+	}
+	c := d1.Dot(r)
+	if sqrLength2 <= epsilon {
+		return F32Clamp01(-c / sqrLength1), 0
+	}
+
+	d12 := d1.Dot(d2)
+	denominator := sqrLength1*sqrLength2 - d12*d12
+	if denominator > epsilon {
+		s = F32Clamp01((d12*f - c*sqrLength2) / denominator)
+	}
+	t = (d12*s + f) / sqrLength2
+	if t < 0 {
+		t = 0
+		s = F32Clamp01(-c / sqrLength1)
+	} else if t > 1 {
+		t = 1
+		s = F32Clamp01((d12 - c) / sqrLength1)
+	}
+	return s, t
+}
+
+// _SegmentBoundsSqrDistance the squared distance is convex along the segment, so golden section search is exact enough
+func _SegmentBoundsSqrDistance(segment Segment, bounds Bounds) float32 {
+	const invPhi = 0.618034
+	var lo, hi float32 = 0, 1
+	x1 := hi - invPhi*(hi-lo)
+	x2 := lo + invPhi*(hi-lo)
+	f1 := bounds.SqrDistance(segment.GetPoint(x1))
+	f2 := bounds.SqrDistance(segment.GetPoint(x2))
+	for i := 0; i < 32 && hi-lo > 1e-6; i++ {
+		if f1 <= f2 {
+			hi, x2, f2 = x2, x1, f1
+			x1 = hi - invPhi*(hi-lo)
+			f1 = bounds.SqrDistance(segment.GetPoint(x1))
+		} else {
+			lo, x1, f1 = x1, x2, f2
+			x2 = lo + invPhi*(hi-lo)
+			f2 = bounds.SqrDistance(segment.GetPoint(x2))
+		}
+	}
+	result := F32Min(f1, f2)
+	result = F32Min(result, bounds.SqrDistance(segment.Start))
+	return F32Min(result, bounds.SqrDistance(segment.End))
+}
@@ -0,0 +1,338 @@
+package gmath
+
+import "math"
+
+// Shape2D implemented by Rect, Circle, OBB2D, Capsule2D and Polygon, all on the XZ plane
+type Shape2D interface {
+	Contains(point Vector2) bool
+	GetRect() Rect
+	// Linecast hit at fraction 0 if start is inside
+	Linecast(start Vector2, end Vector2) (RaycastHit2D, bool)
+
+	// _Convexes the convex pieces of the shape
+	_Convexes() []_Convex2D
+}
+
+// _Convex2D the points within radius of a convex polygon, a circle has 1 point, a capsule has 2
+type _Convex2D struct {
+	points []Vector2
+	radius float32
+}
+
+// Overlap2D touching shapes are overlapping, concave polygons are tested by their triangles
+func Overlap2D(a Shape2D, b Shape2D) bool {
+	for _, convexA := range a._Convexes() {
+		for _, convexB := range b._Convexes() {
+			if _, _, ok := _ConvexPenetration(convexA, convexB); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ComputePenetration2D the minimum translation that separates a from b is direction * distance.
+// Concave polygons try the edge normals of their triangles and the directions of the overlapping pairs,
+// moving the whole shape along each until the last pair is out, so the result always separates the shapes
+func ComputePenetration2D(a Shape2D, b Shape2D) (direction Vector2, distance float32, ok bool) {
+	convexesA, convexesB := a._Convexes(), b._Convexes()
+	if len(convexesA) == 1 && len(convexesB) == 1 {
+		return _ConvexPenetration(convexesA[0], convexesB[0])
+	}
+
+	var candidates []Vector2
+	differences := make([]_Convex2D, 0, len(convexesA)*len(convexesB))
+	for _, convexA := range convexesA {
+		candidates = convexA._Axes(candidates)
+		for _, convexB := range convexesB {
+			if pieceDirection, _, pieceOk := _ConvexPenetration(convexA, convexB); pieceOk {
+				candidates = append(candidates, pieceDirection)
+				ok = true
+			}
+			differences = append(differences, _ConvexDifference(convexB, convexA))
+		}
+	}
+	if !ok {
+		return Vector2{}, 0, false
+	}
+	for _, convexB := range convexesB {
+		candidates = convexB._Axes(candidates)
+	}
+
+	distance = math.MaxFloat32
+	for _, candidate := range candidates {
+		for _, dir := range [2]Vector2{candidate, candidate.Scale(-1)} {
+			var exit float32
+			for _, difference := range differences {
+				if exit = F32Max(exit, difference._Exit(dir)); exit >= distance {
+					break
+				}
+			}
+			if exit < distance {
+				direction, distance = dir, exit
+			}
+		}
+	}
+	return direction, distance, true
+}
+
+func _V2SignedArea(points []Vector2) float32 {
+	var area float32
+	for i, p := range points {
+		area += p.Cross(points[(i+1)%len(points)])
+	}
+	return area * 0.5
+}
+
+func _V2Center(points []Vector2) Vector2 {
+	var center Vector2
+	for _, p := range points {
+		center.AddSelf(p)
+	}
+	return center.Scale(1 / float32(len(points)))
+}
+
+func _V2RectFromPoints(points []Vector2) Rect {
+	if len(points) == 0 {
+		return Rect{}
+	}
+	min, max := points[0], points[0]
+	for _, p := range points[1:] {
+		min = V2Min(min, p)
+		max = V2Max(max, p)
+	}
+	return RectMinMax(min, max)
+}
+
+// _V2PolygonContains crossing number, works for concave polygons
+func _V2PolygonContains(points []Vector2, point Vector2) bool {
+	inside := false
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		a, b := points[i], points[j]
+		if (a.Y > point.Y) != (b.Y > point.Y) && point.X < (b.X-a.X)*(point.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// _V2Edges calls fn for each edge, a 2 points core has 1 edge and a 1 point core has a degenerate edge
+func _V2Edges(points []Vector2, fn func(a, b Vector2)) {
+	switch len(points) {
+	case 0:
+	case 1:
+		fn(points[0], points[0])
+	case 2:
+		fn(points[0], points[1])
+	default:
+		for i, p := range points {
+			fn(p, points[(i+1)%len(points)])
+		}
+	}
+}
+
+// _V2SegmentClosestPoints closest points between segment ab and segment cd
+func _V2SegmentClosestPoints(a, b, c, d Vector2) (Vector2, Vector2) {
+	s, t := _SegmentClosestParams(Segment{a.X0Y(), b.X0Y()}, Segment{c.X0Y(), d.X0Y()})
+	return V2LerpUnclamped(a, b, s), V2LerpUnclamped(c, d, t)
+}
+
+func (convex _Convex2D) _Contains(point Vector2) bool {
+	if len(convex.points) >= 3 && _V2PolygonContains(convex.points, point) {
+		return true
+	}
+	sqrRadius := convex.radius * convex.radius
+	found := false
+	_V2Edges(convex.points, func(a, b Vector2) {
+		closest, _ := _V2SegmentClosestPoints(a, b, point, point)
+		found = found || V2DistanceSqr(closest, point) <= sqrRadius
+	})
+	return found
+}
+
+// _Axes the separating axes of the core
+func (convex _Convex2D) _Axes(axes []Vector2) []Vector2 {
+	if len(convex.points) == 2 {
+		dir := convex.points[1].Substract(convex.points[0])
+		if !dir.IsZero() {
+			dir = dir.Normalize()
+			axes = append(axes, dir, dir.Perpendicular())
+		}
+		return axes
+	}
+	if len(convex.points) >= 3 {
+		_V2Edges(convex.points, func(a, b Vector2) {
+			if edge := b.Substract(a); !edge.IsZero() {
+				axes = append(axes, edge.Normalize().Perpendicular())
+			}
+		})
+	}
+	return axes
+}
+
+func (convex _Convex2D) _Project(axis Vector2) (float32, float32) {
+	min := float32(math.MaxFloat32)
+	max := -min
+	for _, p := range convex.points {
+		d := p.Dot(axis)
+		min = F32Min(min, d)
+		max = F32Max(max, d)
+	}
+	return min, max
+}
+
+// _ConvexPenetration separating axis test of the cores, or the distance of the cores if they are separated.
+// If the cores overlap, the depth on an axis is how far a moves along it, or against it, to leave b
+func _ConvexPenetration(a _Convex2D, b _Convex2D) (direction Vector2, distance float32, ok bool) {
+	radius := a.radius + b.radius
+	offset := _V2Center(a.points).Substract(_V2Center(b.points))
+
+	var buffer [16]Vector2
+	axes := b._Axes(a._Axes(buffer[:0]))
+	separated := len(axes) == 0
+	depth := float32(math.MaxFloat32)
+	for _, axis := range axes {
+		minA, maxA := a._Project(axis)
+		minB, maxB := b._Project(axis)
+		forward, backward := maxB-minA, maxA-minB
+		if forward < 0 || backward < 0 {
+			separated = true
+			break
+		}
+		if forward < depth {
+			depth = forward
+			direction = axis
+		}
+		if backward < depth {
+			depth = backward
+			direction = axis.Scale(-1)
+		}
+	}
+	if !separated {
+		return direction, depth + radius, true
+	}
+
+	sqrDistance := float32(math.MaxFloat32)
+	_V2Edges(a.points, func(a0, a1 Vector2) {
+		_V2Edges(b.points, func(b0, b1 Vector2) {
+			pa, pb := _V2SegmentClosestPoints(a0, a1, b0, b1)
+			if d := V2DistanceSqr(pa, pb); d < sqrDistance {
+				sqrDistance = d
+				direction = pa.Substract(pb)
+			}
+		})
+	})
+	if sqrDistance > radius*radius {
+		return Vector2{}, 0, false
+	}
+	distance = F32Sqrt(sqrDistance)
+	if distance > Epsilon {
+		direction = direction.Scale(1 / distance)
+	} else if !offset.IsZero() {
+		direction = offset.Normalize()
+	} else {
+		direction = V2Right()
+	}
+	return direction, radius - distance, true
+}
+
+// _ConvexDifference b - a, a moved by t overlaps b if t is in it
+func _ConvexDifference(b _Convex2D, a _Convex2D) _Convex2D {
+	points := make([]Vector2, 0, len(a.points)*len(b.points))
+	for _, pb := range b.points {
+		for _, pa := range a.points {
+			points = append(points, pb.Substract(pa))
+		}
+	}
+	return _Convex2D{Polygon{points}.ConvexHull().Points, a.radius + b.radius}
+}
+
+// _Exit how far the origin moves along the unit direction to leave the convex, 0 if it never enters
+func (convex _Convex2D) _Exit(direction Vector2) float32 {
+	far := convex.radius + 1
+	for _, p := range convex.points {
+		far = F32Max(far, p.Magnitude()+convex.radius+1)
+	}
+	// back from far away, the first hit is where the origin leaves
+	hit, ok := _V2Linecast(convex.points, convex.radius, false, direction.Scale(far), Vector2{})
+	if !ok {
+		return 0
+	}
+	return far - hit.Distance
+}
+
+// _Linecast the first hit of the offset edges and the rounded vertices
+func (convex _Convex2D) _Linecast(start Vector2, end Vector2) (RaycastHit2D, bool) {
+	return _V2Linecast(convex.points, convex.radius, convex._Contains(start), start, end)
+}
+
+func _V2Linecast(points []Vector2, radius float32, inside bool, start Vector2, end Vector2) (RaycastHit2D, bool) {
+	dir := end.Substract(start)
+	length := dir.Magnitude()
+	if inside {
+		normal := Vector2{}
+		if length > 0 {
+			normal = dir.Scale(-1 / length)
+		}
+		return RaycastHit2D{start, normal, 0, 0}, true
+	}
+
+	var hit RaycastHit2D
+	hit.Fraction = math.MaxFloat32
+	clockwise := _V2SignedArea(points) > 0
+	tryEdge := func(a, b Vector2, normal Vector2) {
+		offset := normal.Scale(radius)
+		a, b = a.Add(offset), b.Add(offset)
+		edge := b.Substract(a)
+		denominator := dir.Cross(edge)
+		if F32IsZero2(denominator, 1e-12) || dir.Dot(normal) >= 0 {
+			return
+		}
+		diff := a.Substract(start)
+		t := diff.Cross(edge) / denominator
+		u := diff.Cross(dir) / denominator
+		if t >= 0 && t <= 1 && u >= 0 && u <= 1 && t < hit.Fraction {
+			hit.Fraction = t
+			hit.Normal = normal
+		}
+	}
+	_V2Edges(points, func(a, b Vector2) {
+		edge := b.Substract(a)
+		if edge.IsZero() {
+			return
+		}
+		normal := edge.Normalize().Perpendicular()
+		if len(points) == 2 {
+			tryEdge(a, b, normal)
+			tryEdge(a, b, normal.Scale(-1))
+		} else if clockwise {
+			tryEdge(a, b, normal.Scale(-1))
+		} else {
+			tryEdge(a, b, normal)
+		}
+	})
+	if radius > 0 {
+		a := dir.SqrMagnitude()
+		for _, center := range points {
+			f := start.Substract(center)
+			b := f.Dot(dir)
+			c := f.SqrMagnitude() - radius*radius
+			discriminant := b*b - a*c
+			if a == 0 || discriminant < 0 {
+				continue
+			}
+			t := (-b - F32Sqrt(discriminant)) / a
+			if t >= 0 && t <= 1 && t < hit.Fraction {
+				hit.Fraction = t
+				hit.Normal = V2LerpUnclamped(start, end, t).Substract(center).Normalize()
+			}
+		}
+	}
+
+	if hit.Fraction > 1 {
+		return RaycastHit2D{}, false
+	}
+	hit.Point = V2LerpUnclamped(start, end, hit.Fraction)
+	hit.Distance = hit.Fraction * length
+	return hit, true
+}
@@ -0,0 +1,172 @@
+package gmath
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestShape2D(t *testing.T) {
+	rect := RectMinMax(Vector2{0, 0}, Vector2{2, 2})
+	circle := Circle{Vector2{3, 1}, 1.5}
+	obb := OBB2D{Vector2{0, 0}, Vector2{1, 1}, AngleDegree(45).ToRadian()}
+	capsule := Capsule2D{Vector2{-2, 5}, Vector2{2, 5}, 1}
+	// L shape, concave
+	l := Polygon{[]Vector2{{0, 0}, {0, 4}, {1, 4}, {1, 1}, {4, 1}, {4, 0}}}
+
+	corners := rect.Corners()
+	if l.IsConvex() || !(Polygon{corners[:]}).IsConvex() || !l.IsClockwise() || !F32Equal(l.Area(), 7) {
+		t.Error("Polygon")
+	}
+	if !l.Contains(Vector2{0.5, 3}) || l.Contains(Vector2{2, 2}) || len(l.Triangulate()) != 4 {
+		t.Error("Polygon.Contains")
+	}
+	if hull := l.ConvexHull(); len(hull.Points) != 5 || !F32Equal(hull.Area(), 11.5) || !hull.IsClockwise() {
+		t.Error("Polygon.ConvexHull")
+	}
+	if !obb.Contains(Vector2{0, 1.4}) || obb.Contains(Vector2{0.8, 0.8}) || !capsule.Contains(Vector2{2.5, 5.5}) {
+		t.Error("Contains")
+	}
+
+	if !Overlap2D(rect, circle) || Overlap2D(circle, capsule) || !Overlap2D(obb, rect) || Overlap2D(capsule, rect) {
+		t.Error("Overlap2D")
+	}
+	// the circle is in the notch of the L, only the hull overlaps
+	notch := Circle{Vector2{2.5, 2.5}, 1}
+	if Overlap2D(l, notch) || !Overlap2D(l, Circle{Vector2{2.5, 2.5}, 1.6}) {
+		t.Error("Overlap2D concave")
+	}
+	if _, _, ok := ComputePenetration2D(notch, l); ok {
+		t.Error("ComputePenetration2D concave miss")
+	}
+	// the rect covers the corner of the L, out of the lower triangle is into the next one
+	dir, distance, ok := ComputePenetration2D(RectMinMax(Vector2{-0.5, -0.5}, Vector2{1.2, 1.5}), l)
+	if !ok || !dir.Equal(V2Left()) || !F32Equal(distance, 1.2) {
+		t.Error("ComputePenetration2D concave corner")
+	}
+	// the circle overlaps the upper arm of the L, pushed out to the right
+	arm := Circle{Vector2{1.5, 3}, 1}
+	dir, distance, ok = ComputePenetration2D(arm, l)
+	if !ok || !dir.Equal(V2Right()) || !F32Equal(distance, 0.5) {
+		t.Error("ComputePenetration2D concave")
+	}
+
+	dir, distance, ok = ComputePenetration2D(circle, rect)
+	if !ok || !dir.Equal(V2Right()) || !F32Equal(distance, 0.5) {
+		t.Error("ComputePenetration2D circle")
+	}
+	moved := Circle{circle.Center.Add(dir.Scale(distance + 0.01)), circle.Radius}
+	if Overlap2D(moved, rect) {
+		t.Error("ComputePenetration2D separate")
+	}
+	dir, distance, ok = ComputePenetration2D(obb, rect)
+	if !ok || !F32Equal2(distance, 1, 1e-4) || !dir.Equal(Vector2{-1, -1}.Normalize()) {
+		t.Error("ComputePenetration2D obb")
+	}
+	if other := (OBB2D{obb.Center.Add(dir.Scale(distance + 0.01)), obb.Extents, obb.Rotation}); Overlap2D(other, rect) {
+		t.Error("ComputePenetration2D obb separate")
+	}
+	dir, distance, ok = ComputePenetration2D(Capsule2D{Vector2{-2, 2.5}, Vector2{4, 2.5}, 1}, rect)
+	if !ok || !dir.Equal(V2Up()) || !F32Equal(distance, 0.5) {
+		t.Error("ComputePenetration2D capsule")
+	}
+	// the projection of rect is inside the other on x, it leaves by the nearer side
+	dir, distance, ok = ComputePenetration2D(rect, RectMinMax(Vector2{-0.2, -10}, Vector2{3, 10}))
+	if !ok || !dir.Equal(V2Left()) || !F32Equal(distance, 2.2) {
+		t.Error("ComputePenetration2D contained")
+	}
+	// the center of the circle is inside the rect
+	dir, distance, ok = ComputePenetration2D(Circle{Vector2{1, 0.2}, 0.5}, rect)
+	if !ok || !dir.Equal(V2Down()) || !F32Equal(distance, 0.7) {
+		t.Error("ComputePenetration2D center inside")
+	}
+	dir, distance, ok = ComputePenetration2D(Capsule2D{Vector2{0.5, 1.6}, Vector2{1.5, 1.6}, 0.3}, rect)
+	if !ok || !dir.Equal(V2Up()) || !F32Equal(distance, 0.7) {
+		t.Error("ComputePenetration2D capsule inside")
+	}
+
+	if hit, ok := rect.Linecast(Vector2{-2, 1}, Vector2{2, 1}); !ok || !F32Equal(hit.Fraction, 0.5) || !hit.Normal.Equal(V2Left()) || !hit.Point.Equal(Vector2{0, 1}) {
+		t.Error("Rect.Linecast")
+	}
+	if hit, ok := circle.Linecast(Vector2{3, 10}, Vector2{3, 0}); !ok || !F32Equal(hit.Distance, 7.5) || !hit.Normal.Equal(V2Up()) {
+		t.Error("Circle.Linecast")
+	}
+	if hit, ok := capsule.Linecast(Vector2{0, 0}, Vector2{0, 10}); !ok || !F32Equal(hit.Distance, 4) || !hit.Normal.Equal(V2Down()) {
+		t.Error("Capsule2D.Linecast")
+	}
+	if hit, ok := capsule.Linecast(Vector2{-10, 5}, Vector2{0, 5}); !ok || !F32Equal(hit.Distance, 7) || !hit.Normal.Equal(V2Left()) {
+		t.Error("Capsule2D.Linecast cap")
+	}
+	if hit, ok := obb.Linecast(Vector2{-5, 0}, Vector2{5, 0}); !ok || !F32Equal2(hit.Distance, 5-F32Sqrt(2), 1e-4) || hit.Normal.X >= 0 {
+		t.Error("OBB2D.Linecast")
+	}
+	if hit, ok := Raycast2D(NewRay2D(Vector2{3, 3}, V2Left()), 10, l); !ok || !F32Equal(hit.Distance, 2) || !hit.Normal.Equal(V2Right()) {
+		t.Error("Polygon.Linecast")
+	}
+	if _, ok := l.Linecast(Vector2{3, 3}, Vector2{3, 1.5}); ok {
+		t.Error("Polygon.Linecast miss")
+	}
+	if hit, ok := l.Linecast(Vector2{0.5, 0.5}, Vector2{3, 3}); !ok || hit.Fraction != 0 {
+		t.Error("Polygon.Linecast inside")
+	}
+}
+
+// _MoveShape2D the shape translated by offset
+func _MoveShape2D(shape Shape2D, offset Vector2) Shape2D {
+	switch v := shape.(type) {
+	case Rect:
+		return Rect{v.Position.Add(offset), v.Size}
+	case Circle:
+		return Circle{v.Center.Add(offset), v.Radius}
+	case OBB2D:
+		return OBB2D{v.Center.Add(offset), v.Extents, v.Rotation}
+	case Capsule2D:
+		return Capsule2D{v.Start.Add(offset), v.End.Add(offset), v.Radius}
+	case Polygon:
+		points := make([]Vector2, len(v.Points))
+		for i, p := range v.Points {
+			points[i] = p.Add(offset)
+		}
+		return Polygon{points}
+	}
+	return nil
+}
+
+func TestComputePenetration2D(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func(scale float32) float32 { return (r.Float32()*2 - 1) * scale }
+	shapes := []func() Shape2D{
+		func() Shape2D {
+			return RectCenterSize(Vector2{random(2), random(2)}, Vector2{0.2 + r.Float32()*3, 0.2 + r.Float32()*3})
+		},
+		func() Shape2D { return Circle{Vector2{random(2), random(2)}, 0.1 + r.Float32()*1.5} },
+		func() Shape2D {
+			return OBB2D{Vector2{random(2), random(2)}, Vector2{0.1 + r.Float32()*1.5, 0.1 + r.Float32()*1.5}, AngleRadian(random(PI))}
+		},
+		func() Shape2D {
+			return Capsule2D{Vector2{random(2), random(2)}, Vector2{random(2), random(2)}, 0.1 + r.Float32()}
+		},
+		// L shape, concave
+		func() Shape2D {
+			x, y, size := random(2), random(2), 0.5+r.Float32()*2
+			return Polygon{[]Vector2{{x, y}, {x, y + size}, {x + size/3, y + size}, {x + size/3, y + size/3}, {x + size, y + size/3}, {x + size, y}}}
+		},
+	}
+
+	for i := 0; i < 2000; i++ {
+		a := shapes[r.Intn(len(shapes))]()
+		b := shapes[r.Intn(len(shapes))]()
+		dir, distance, ok := ComputePenetration2D(a, b)
+		if ok != Overlap2D(a, b) {
+			t.Error("ComputePenetration2D ok")
+			continue
+		}
+		if !ok {
+			continue
+		}
+		// a bit further is out, a bit less is still in
+		if !F32Equal(dir.Magnitude(), 1) || Overlap2D(_MoveShape2D(a, dir.Scale(distance+0.01)), b) ||
+			distance > 0.01 && !Overlap2D(_MoveShape2D(a, dir.Scale(distance-0.01)), b) {
+			t.Error("ComputePenetration2D separate")
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package gmath
+
+// SpatialHash uniform grid of entities keyed by id, for "who is within R" queries
+//
+// Only the occupied cells are stored, so the world can be unbounded
+type SpatialHash struct {
+	cellSize    float32
+	invCellSize float32
+	xz          bool
+
+	cells   map[Vector3Int]*_SpatialHashCell
+	entries map[int64]_SpatialHashEntry
+}
+
+type _SpatialHashItem struct {
+	id       int64
+	position Vector3
+}
+
+type _SpatialHashCell struct {
+	key   Vector3Int
+	items []_SpatialHashItem
+}
+
+type _SpatialHashEntry struct {
+	cell  *_SpatialHashCell
+	index int
+}
+
+// NewSpatialHash 3D grid
+func NewSpatialHash(cellSize float32) *SpatialHash {
+	return &SpatialHash{
+		cellSize:    cellSize,
+		invCellSize: 1 / cellSize,
+		cells:       make(map[Vector3Int]*_SpatialHashCell),
+		entries:     make(map[int64]_SpatialHashEntry),
+	}
+}
+
+// NewSpatialHashXZ 2D grid on the XZ plane, Y is ignored by the cells and the queries
+func NewSpatialHashXZ(cellSize float32) *SpatialHash {
+	hash := NewSpatialHash(cellSize)
+	hash.xz = true
+	return hash
+}
+
+func (hash *SpatialHash) CellSize() float32 {
+	return hash.cellSize
+}
+
+func (hash *SpatialHash) Count() int {
+	return len(hash.entries)
+}
+
+// Insert false if the id exists
+func (hash *SpatialHash) Insert(id int64, position Vector3) bool {
+	if _, ok := hash.entries[id]; ok {
+		return false
+	}
+	hash._Add(id, position, hash._CellKey(position))
+	return true
+}
+
+// Move false if the id does not exist, the cells are not touched if the entity stays in its cell
+func (hash *SpatialHash) Move(id int64, position Vector3) bool {
+	entry, ok := hash.entries[id]
+	if !ok {
+		return false
+	}
+	key := hash._CellKey(position)
+	if key == entry.cell.key {
+		entry.cell.items[entry.index].position = position
+		return true
+	}
+	hash._Remove(entry)
+	hash._Add(id, position, key)
+	return true
+}
+
+// Remove false if the id does not exist
+func (hash *SpatialHash) Remove(id int64) bool {
+	entry, ok := hash.entries[id]
+	if !ok {
+		return false
+	}
+	hash._Remove(entry)
+	delete(hash.entries, id)
+	return true
+}
+
+func (hash *SpatialHash) Get(id int64) (Vector3, bool) {
+	entry, ok := hash.entries[id]
+	if !ok {
+		return Vector3{}, false
+	}
+	return entry.cell.items[entry.index].position, true
+}
+
+func (hash *SpatialHash) Clear() {
+	hash.cells = make(map[Vector3Int]*_SpatialHashCell)
+	hash.entries = make(map[int64]_SpatialHashEntry)
+}
+
+// QueryRadius appends the ids within radius to result, see ForEachInRadius
+func (hash *SpatialHash) QueryRadius(center Vector3, radius float32, result []int64) []int64 {
+	hash.ForEachInRadius(center, radius, func(id int64, _ Vector3) bool {
+		result = append(result, id)
+		return true
+	})
+	return result
+}
+
+// QueryBounds appends the ids inside bounds to result, see ForEachInBounds
+func (hash *SpatialHash) QueryBounds(bounds Bounds, result []int64) []int64 {
+	hash.ForEachInBounds(bounds, func(id int64, _ Vector3) bool {
+		result = append(result, id)
+		return true
+	})
+	return result
+}
+
+// ForEachInRadius the distance is V3DistanceSqr, or V3DistanceXZSqr for the XZ grid, return false in fn to stop
+//
+// fn must not modify the hash
+func (hash *SpatialHash) ForEachInRadius(center Vector3, radius float32, fn func(id int64, position Vector3) bool) {
+	sqrRadius := radius * radius
+	extents := Vector3{radius, radius, radius}
+	hash._ForEachCell(center.Substract(extents), center.Add(extents), func(cell *_SpatialHashCell) bool {
+		for _, item := range cell.items {
+			var sqrDistance float32
+			if hash.xz {
+				sqrDistance = V3DistanceXZSqr(center, item.position)
+			} else {
+				sqrDistance = V3DistanceSqr(center, item.position)
+			}
+			if sqrDistance <= sqrRadius && !fn(item.id, item.position) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// ForEachInBounds Y is ignored for the XZ grid, return false in fn to stop, fn must not modify the hash
+func (hash *SpatialHash) ForEachInBounds(bounds Bounds, fn func(id int64, position Vector3) bool) {
+	min, max := bounds.Min(), bounds.Max()
+	hash._ForEachCell(min, max, func(cell *_SpatialHashCell) bool {
+		for _, item := range cell.items {
+			p := item.position
+			if p.X < min.X || p.X > max.X || p.Z < min.Z || p.Z > max.Z {
+				continue
+			}
+			if !hash.xz && (p.Y < min.Y || p.Y > max.Y) {
+				continue
+			}
+			if !fn(item.id, p) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (hash *SpatialHash) _CellKey(position Vector3) Vector3Int {
+	key := V3FloorToInt(position.Scale(hash.invCellSize))
+	if hash.xz {
+		key.Y = 0
+	}
+	return key
+}
+
+func (hash *SpatialHash) _Add(id int64, position Vector3, key Vector3Int) {
+	cell, ok := hash.cells[key]
+	if !ok {
+		cell = &_SpatialHashCell{key: key}
+		hash.cells[key] = cell
+	}
+	cell.items = append(cell.items, _SpatialHashItem{id, position})
+	hash.entries[id] = _SpatialHashEntry{cell, len(cell.items) - 1}
+}
+
+// _Remove swap with the last item of the cell, the entry of id is not deleted
+func (hash *SpatialHash) _Remove(entry _SpatialHashEntry) {
+	cell := entry.cell
+	last := len(cell.items) - 1
+	if entry.index != last {
+		moved := cell.items[last]
+		cell.items[entry.index] = moved
+		hash.entries[moved.id] = _SpatialHashEntry{cell, entry.index}
+	}
+	cell.items = cell.items[:last]
+	if last == 0 {
+		delete(hash.cells, cell.key)
+	}
+}
+
+// _ForEachCell the occupied cells overlapping [min, max], scans all cells if that is cheaper
+func (hash *SpatialHash) _ForEachCell(min Vector3, max Vector3, fn func(cell *_SpatialHashCell) bool) {
+	from := hash._CellKey(min)
+	to := hash._CellKey(max)
+	if to.X < from.X || to.Y < from.Y || to.Z < from.Z {
+		return
+	}
+	// float32 to avoid overflow for huge queries
+	count := float32(to.X-from.X+1) * float32(to.Y-from.Y+1) * float32(to.Z-from.Z+1)
+	if count > float32(len(hash.cells)) {
+		for _, cell := range hash.cells {
+			key := cell.key
+			if key.X < from.X || key.X > to.X || key.Y < from.Y || key.Y > to.Y || key.Z < from.Z || key.Z > to.Z {
+				continue
+			}
+			if !fn(cell) {
+				return
+			}
+		}
+		return
+	}
+	for x := from.X; x <= to.X; x++ {
+		for y := from.Y; y <= to.Y; y++ {
+			for z := from.Z; z <= to.Z; z++ {
+				if cell, ok := hash.cells[Vector3Int{x, y, z}]; ok && !fn(cell) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package gmath
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSpatialHash(t *testing.T) {
+	hash := NewSpatialHash(10)
+	for i := int64(0); i < 100; i++ {
+		hash.Insert(i, Vector3{float32(i), 0, 0})
+	}
+	if hash.Insert(5, V3Zero()) || hash.Count() != 100 {
+		t.Error("SpatialHash.Insert")
+	}
+
+	ids := hash.QueryRadius(Vector3{50, 3, 0}, 5, nil)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if len(ids) != 9 || ids[0] != 46 || ids[8] != 54 {
+		t.Error("SpatialHash.QueryRadius")
+	}
+	if ids := hash.QueryBounds(BoundsMinMax(Vector3{-5, -1, -1}, Vector3{2.5, 1, 1}), nil); len(ids) != 3 {
+		t.Error("SpatialHash.QueryBounds")
+	}
+
+	// same cell, then another cell
+	hash.Move(50, Vector3{51, 0, 0})
+	if p, _ := hash.Get(50); !p.Equal(Vector3{51, 0, 0}) {
+		t.Error("SpatialHash.Move")
+	}
+	hash.Move(50, Vector3{-100, 0, 0})
+	if ids := hash.QueryRadius(Vector3{-100, 0, 0}, 1, nil); len(ids) != 1 || ids[0] != 50 {
+		t.Error("SpatialHash.Move cell")
+	}
+	if !hash.Remove(50) || hash.Remove(50) || hash.Count() != 99 || len(hash.QueryRadius(Vector3{-100, 0, 0}, 1, nil)) != 0 {
+		t.Error("SpatialHash.Remove")
+	}
+	// the swapped items are still found
+	hash.Remove(41)
+	if ids := hash.QueryRadius(Vector3{45, 0, 0}, 4.5, nil); len(ids) != 8 {
+		t.Error("SpatialHash.Remove swap")
+	}
+	if len(hash.QueryRadius(V3Zero(), 1e9, nil)) != 98 {
+		t.Error("SpatialHash.QueryRadius all")
+	}
+
+	xz := NewSpatialHashXZ(10)
+	xz.Insert(1, Vector3{0, 100, 0})
+	if len(xz.QueryRadius(V3Zero(), 1, nil)) != 1 {
+		t.Error("SpatialHashXZ")
+	}
+
+	buffer := make([]int64, 0, 16)
+	count := 0
+	allocs := testing.AllocsPerRun(10, func() {
+		buffer = hash.QueryRadius(Vector3{20, 0, 0}, 5, buffer[:0])
+		hash.ForEachInRadius(Vector3{20, 0, 0}, 5, func(id int64, position Vector3) bool {
+			count++
+			return true
+		})
+	})
+	if allocs != 0 || len(buffer) != 11 {
+		t.Error("SpatialHash allocs")
+	}
+}
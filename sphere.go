@@ -0,0 +1,56 @@
+package gmath
+
+type Sphere struct {
+	Center Vector3 `json:"center"`
+	Radius float32 `json:"radius"`
+}
+
+func (sphere Sphere) Contains(point Vector3) bool {
+	return V3DistanceSqr(sphere.Center, point) <= sphere.Radius*sphere.Radius
+}
+
+func (sphere Sphere) GetBounds() Bounds {
+	return Bounds{sphere.Center, Vector3{sphere.Radius, sphere.Radius, sphere.Radius}}
+}
+
+func (sphere Sphere) ClosestPoint(point Vector3) Vector3 {
+	offset := point.Substract(sphere.Center)
+	if offset.SqrMagnitude() <= sphere.Radius*sphere.Radius {
+		return point
+	}
+	return sphere.Center.Add(offset.Normalize().Scale(sphere.Radius))
+}
+
+// Transform the radius is scaled by the max scale of the matrix
+func (sphere Sphere) Transform(matrix *Matrix4) Sphere {
+	return Sphere{matrix.MultiplyPoint3x4(sphere.Center), sphere.Radius * _Matrix4MaxScale(matrix)}
+}
+
+// Raycast hit at distance 0 if the origin is inside
+func (sphere Sphere) Raycast(ray Ray) (RaycastHit, bool) {
+	offset := ray.Origin.Substract(sphere.Center)
+	c := offset.SqrMagnitude() - sphere.Radius*sphere.Radius
+	if c <= 0 {
+		return _NewRaycastHit(ray, 0, ray.Direction.Scale(-1)), true
+	}
+	b := offset.Dot(ray.Direction)
+	if b > 0 {
+		return RaycastHit{}, false
+	}
+	discriminant := b*b - c
+	if discriminant < 0 {
+		return RaycastHit{}, false
+	}
+	distance := -b - F32Sqrt(discriminant)
+	hit := _NewRaycastHit(ray, distance, V3Zero())
+	hit.Normal = hit.Point.Substract(sphere.Center).Normalize()
+	return hit, true
+}
+
+// _Matrix4MaxScale the max length of the basis vectors
+func _Matrix4MaxScale(matrix *Matrix4) float32 {
+	x := Vector3{matrix.m00, matrix.m10, matrix.m20}.SqrMagnitude()
+	y := Vector3{matrix.m01, matrix.m11, matrix.m21}.SqrMagnitude()
+	z := Vector3{matrix.m02, matrix.m12, matrix.m22}.SqrMagnitude()
+	return F32Sqrt(F32Max(x, F32Max(y, z)))
+}
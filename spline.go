@@ -0,0 +1,279 @@
+package gmath
+
+import "sort"
+
+const (
+	_SplineArcLengthSamples = 16
+)
+
+// Spline3 multi segment path, t [0,1] over the whole path
+//
+// Every segment covers [knots[i], knots[i+1]] of t, so that the derivative by t is continuous
+// at the joints of Catmull-Rom, B-Spline and Hermite splines
+type Spline3 struct {
+	segments []BezierCubic
+	knots    []float32
+
+	// arcLengths[i*samples+j] the length from the start to sample j of segment i
+	arcLengths []float32
+}
+
+// Spline3FromSegments every segment has the same span of t
+func Spline3FromSegments(segments []BezierCubic) Spline3 {
+	knots := make([]float32, len(segments)+1)
+	for i := range knots {
+		knots[i] = float32(i)
+	}
+	return _NewSpline3(append([]BezierCubic(nil), segments...), knots)
+}
+
+// Spline3FromBezier points are [p0, c0, c1, p1, c2, c3, p2, ...], len = 3n+1
+func Spline3FromBezier(points []Vector3) Spline3 {
+	count := (len(points) - 1) / 3
+	segments := make([]BezierCubic, 0, count)
+	for i := 0; i < count; i++ {
+		segments = append(segments, BezierCubic{points[i*3], points[i*3+1], points[i*3+2], points[i*3+3]})
+	}
+	return Spline3FromSegments(segments)
+}
+
+// Spline3FromHermite tangents are the derivatives at each point by segment
+func Spline3FromHermite(points []Vector3, tangents []Vector3) Spline3 {
+	count := len(points) - 1
+	if len(tangents) < len(points) {
+		count = len(tangents) - 1
+	}
+	if count < 0 {
+		count = 0
+	}
+	segments := make([]BezierCubic, 0, count)
+	for i := 0; i < count; i++ {
+		segments = append(segments, BezierCubicFromHermite(points[i], tangents[i], points[i+1], tangents[i+1]))
+	}
+	return Spline3FromSegments(segments)
+}
+
+// Spline3FromCatmullRom centripetal, pass through all the points
+func Spline3FromCatmullRom(points []Vector3, closed bool) Spline3 {
+	n := len(points)
+	if n < 2 {
+		return Spline3{}
+	}
+
+	point := func(i int) Vector3 {
+		if closed {
+			return points[(i%n+n)%n]
+		}
+		if i < 0 {
+			return points[0].Scale(2).Substract(points[1])
+		}
+		if i >= n {
+			return points[n-1].Scale(2).Substract(points[n-2])
+		}
+		return points[i]
+	}
+
+	count := n - 1
+	if closed {
+		count = n
+	}
+	segments := make([]BezierCubic, 0, count)
+	knots := make([]float32, 0, count+1)
+	knots = append(knots, 0)
+	for i := 0; i < count; i++ {
+		p0, p1, p2, p3 := point(i-1), point(i), point(i+1), point(i+2)
+		segments = append(segments, BezierCubicFromCatmullRom(p0, p1, p2, p3))
+		_, dt1, _ := _CatmullRomIntervals(p0, p1, p2, p3)
+		knots = append(knots, knots[i]+dt1)
+	}
+	return _NewSpline3(segments, knots)
+}
+
+// Spline3FromBSpline uniform cubic, the open spline starts and ends at the end points
+func Spline3FromBSpline(points []Vector3, closed bool) Spline3 {
+	n := len(points)
+	if n < 2 {
+		return Spline3{}
+	}
+
+	point := func(i int) Vector3 {
+		if closed {
+			return points[(i%n+n)%n]
+		}
+		return points[_IntMax(0, _IntMin(i, n-1))]
+	}
+
+	count := n + 1
+	start := -2
+	if closed {
+		count = n
+		start = 0
+	}
+	segments := make([]BezierCubic, 0, count)
+	for i := start; i < start+count; i++ {
+		segments = append(segments, BezierCubicFromBSpline(point(i), point(i+1), point(i+2), point(i+3)))
+	}
+	return Spline3FromSegments(segments)
+}
+
+func _NewSpline3(segments []BezierCubic, knots []float32) Spline3 {
+	if len(segments) == 0 {
+		return Spline3{}
+	}
+	ret := Spline3{segments: segments, knots: knots}
+
+	// normalize knots to [0,1]
+	total := knots[len(knots)-1]
+	for i := range knots {
+		knots[i] /= total
+	}
+	knots[len(knots)-1] = 1
+
+	ret.arcLengths = make([]float32, len(segments)*_SplineArcLengthSamples+1)
+	var length float32
+	last := segments[0].P0
+	for i, segment := range segments {
+		for j := 1; j <= _SplineArcLengthSamples; j++ {
+			p := segment.Evaluate(float32(j) / _SplineArcLengthSamples)
+			length += V3Distance(last, p)
+			last = p
+			ret.arcLengths[i*_SplineArcLengthSamples+j] = length
+		}
+	}
+	return ret
+}
+
+func (spline *Spline3) SegmentCount() int {
+	return len(spline.segments)
+}
+
+func (spline *Spline3) Segment(index int) BezierCubic {
+	return spline.segments[index]
+}
+
+// Length approximated by the arc length table
+func (spline *Spline3) Length() float32 {
+	if len(spline.arcLengths) == 0 {
+		return 0
+	}
+	return spline.arcLengths[len(spline.arcLengths)-1]
+}
+
+// _Locate return the segment index and the local t
+func (spline *Spline3) _Locate(t float32) (int, float32) {
+	t = F32Clamp01(t)
+	index := sort.Search(len(spline.segments), func(i int) bool { return spline.knots[i+1] >= t })
+	if index >= len(spline.segments) {
+		index = len(spline.segments) - 1
+	}
+	span := spline.knots[index+1] - spline.knots[index]
+	if span <= 0 {
+		return index, 0
+	}
+	return index, F32Clamp01((t - spline.knots[index]) / span)
+}
+
+// Evaluate t [0,1], return zero if empty
+func (spline *Spline3) Evaluate(t float32) Vector3 {
+	if len(spline.segments) == 0 {
+		return V3Zero()
+	}
+	index, local := spline._Locate(t)
+	return spline.segments[index].Evaluate(local)
+}
+
+// Derivative by t, the tangent
+func (spline *Spline3) Derivative(t float32) Vector3 {
+	if len(spline.segments) == 0 {
+		return V3Zero()
+	}
+	index, local := spline._Locate(t)
+	span := spline.knots[index+1] - spline.knots[index]
+	return spline.segments[index].Derivative(local).Scale(1 / span)
+}
+
+// TimeAtDistance the t where the arc length from the start is distance
+func (spline *Spline3) TimeAtDistance(distance float32) float32 {
+	count := len(spline.arcLengths)
+	if count == 0 || distance <= 0 {
+		return 0
+	}
+	if distance >= spline.arcLengths[count-1] {
+		return 1
+	}
+
+	sample := sort.Search(count, func(i int) bool { return spline.arcLengths[i] >= distance })
+	from, to := spline.arcLengths[sample-1], spline.arcLengths[sample]
+	local := float32(sample-1) / _SplineArcLengthSamples
+	if to > from {
+		local += (distance - from) / (to - from) / _SplineArcLengthSamples
+	}
+
+	index := (sample - 1) / _SplineArcLengthSamples
+	local -= float32(index)
+	return F32LerpUnclamped(spline.knots[index], spline.knots[index+1], local)
+}
+
+// EvaluateAtDistance sample with constant speed
+func (spline *Spline3) EvaluateAtDistance(distance float32) Vector3 {
+	return spline.Evaluate(spline.TimeAtDistance(distance))
+}
+
+// ClosestPoint return t and the point on the spline
+func (spline *Spline3) ClosestPoint(point Vector3) (float32, Vector3) {
+	if len(spline.segments) == 0 {
+		return 0, V3Zero()
+	}
+	var bestT float32
+	var bestPoint Vector3
+	var bestDistance float32 = -1
+	for i, segment := range spline.segments {
+		local, p := segment.ClosestPoint(point)
+		d := V3DistanceSqr(p, point)
+		if bestDistance < 0 || d < bestDistance {
+			bestDistance = d
+			bestPoint = p
+			bestT = F32LerpUnclamped(spline.knots[i], spline.knots[i+1], local)
+		}
+	}
+	return bestT, bestPoint
+}
+
+// Split at t, return [0,t] and [t,1], t of each part is [0,1] again
+func (spline *Spline3) Split(t float32) (Spline3, Spline3) {
+	if len(spline.segments) == 0 {
+		return Spline3{}, Spline3{}
+	} else if t <= 0 {
+		return Spline3{}, *spline
+	} else if t >= 1 {
+		return *spline, Spline3{}
+	}
+	index, local := spline._Locate(t)
+	left, right := spline.segments[index].Split(local)
+	middle := F32LerpUnclamped(spline.knots[index], spline.knots[index+1], local)
+
+	leftSegments := append(append([]BezierCubic(nil), spline.segments[:index]...), left)
+	leftKnots := append(append([]float32(nil), spline.knots[:index+1]...), middle)
+	rightSegments := append([]BezierCubic{right}, spline.segments[index+1:]...)
+	rightKnots := append([]float32{middle}, spline.knots[index+1:]...)
+	for i := range rightKnots {
+		rightKnots[i] -= middle
+	}
+	return _NewSpline3(leftSegments, leftKnots), _NewSpline3(rightSegments, rightKnots)
+}
+
+// Subdivide split every segment in half, the curve is the same
+func (spline *Spline3) Subdivide() Spline3 {
+	if len(spline.segments) == 0 {
+		return Spline3{}
+	}
+	segments := make([]BezierCubic, 0, len(spline.segments)*2)
+	knots := make([]float32, 0, len(spline.segments)*2+1)
+	for i, segment := range spline.segments {
+		left, right := segment.Split(0.5)
+		segments = append(segments, left, right)
+		knots = append(knots, spline.knots[i], (spline.knots[i]+spline.knots[i+1])*0.5)
+	}
+	knots = append(knots, 1)
+	return _NewSpline3(segments, knots)
+}
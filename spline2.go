@@ -0,0 +1,172 @@
+package gmath
+
+// Spline2 same as Spline3, on the XZ plane through X0Y
+type Spline2 struct {
+	spline Spline3
+}
+
+func _V2ToX0Y(points []Vector2) []Vector3 {
+	ret := make([]Vector3, len(points))
+	for i, v := range points {
+		ret[i] = v.X0Y()
+	}
+	return ret
+}
+
+// Spline2FromSegments every segment has the same span of t
+func Spline2FromSegments(segments []BezierCubic2) Spline2 {
+	cubics := make([]BezierCubic, len(segments))
+	for i, b := range segments {
+		cubics[i] = b._X0Y()
+	}
+	return Spline2{Spline3FromSegments(cubics)}
+}
+
+// Spline2FromBezier points are [p0, c0, c1, p1, c2, c3, p2, ...], len = 3n+1
+func Spline2FromBezier(points []Vector2) Spline2 {
+	return Spline2{Spline3FromBezier(_V2ToX0Y(points))}
+}
+
+// Spline2FromHermite tangents are the derivatives at each point by segment
+func Spline2FromHermite(points []Vector2, tangents []Vector2) Spline2 {
+	return Spline2{Spline3FromHermite(_V2ToX0Y(points), _V2ToX0Y(tangents))}
+}
+
+// Spline2FromCatmullRom centripetal, pass through all the points
+func Spline2FromCatmullRom(points []Vector2, closed bool) Spline2 {
+	return Spline2{Spline3FromCatmullRom(_V2ToX0Y(points), closed)}
+}
+
+// Spline2FromBSpline uniform cubic, the open spline starts and ends at the end points
+func Spline2FromBSpline(points []Vector2, closed bool) Spline2 {
+	return Spline2{Spline3FromBSpline(_V2ToX0Y(points), closed)}
+}
+
+func (spline *Spline2) SegmentCount() int {
+	return spline.spline.SegmentCount()
+}
+
+func (spline *Spline2) Segment(index int) BezierCubic2 {
+	return _BezierCubic2FromXZ(spline.spline.Segment(index))
+}
+
+func (spline *Spline2) Length() float32 {
+	return spline.spline.Length()
+}
+
+func (spline *Spline2) Evaluate(t float32) Vector2 {
+	return spline.spline.Evaluate(t).XZ()
+}
+
+func (spline *Spline2) Derivative(t float32) Vector2 {
+	return spline.spline.Derivative(t).XZ()
+}
+
+func (spline *Spline2) TimeAtDistance(distance float32) float32 {
+	return spline.spline.TimeAtDistance(distance)
+}
+
+func (spline *Spline2) EvaluateAtDistance(distance float32) Vector2 {
+	return spline.spline.EvaluateAtDistance(distance).XZ()
+}
+
+func (spline *Spline2) ClosestPoint(point Vector2) (float32, Vector2) {
+	t, p := spline.spline.ClosestPoint(point.X0Y())
+	return t, p.XZ()
+}
+
+func (spline *Spline2) Split(t float32) (Spline2, Spline2) {
+	left, right := spline.spline.Split(t)
+	return Spline2{left}, Spline2{right}
+}
+
+func (spline *Spline2) Subdivide() Spline2 {
+	return Spline2{spline.spline.Subdivide()}
+}
+
+// BezierQuadratic2 same as BezierQuadratic, on the XZ plane through X0Y
+type BezierQuadratic2 struct {
+	P0 Vector2 `json:"p0"`
+	P1 Vector2 `json:"p1"`
+	P2 Vector2 `json:"p2"`
+}
+
+func (b BezierQuadratic2) _X0Y() BezierQuadratic {
+	return BezierQuadratic{b.P0.X0Y(), b.P1.X0Y(), b.P2.X0Y()}
+}
+
+func _BezierQuadratic2FromXZ(b BezierQuadratic) BezierQuadratic2 {
+	return BezierQuadratic2{b.P0.XZ(), b.P1.XZ(), b.P2.XZ()}
+}
+
+// Evaluate t [0,1]
+func (b BezierQuadratic2) Evaluate(t float32) Vector2 {
+	return b._X0Y().Evaluate(t).XZ()
+}
+
+func (b BezierQuadratic2) Derivative(t float32) Vector2 {
+	return b._X0Y().Derivative(t).XZ()
+}
+
+// Split at t, return [0,t] and [t,1]
+func (b BezierQuadratic2) Split(t float32) (BezierQuadratic2, BezierQuadratic2) {
+	left, right := b._X0Y().Split(t)
+	return _BezierQuadratic2FromXZ(left), _BezierQuadratic2FromXZ(right)
+}
+
+// ClosestPoint return t [0,1] and the point
+func (b BezierQuadratic2) ClosestPoint(point Vector2) (float32, Vector2) {
+	t, p := b._X0Y().ToCubic().ClosestPoint(point.X0Y())
+	return t, p.XZ()
+}
+
+// ToCubic degree elevation, the curve is the same
+func (b BezierQuadratic2) ToCubic() BezierCubic2 {
+	return _BezierCubic2FromXZ(b._X0Y().ToCubic())
+}
+
+// ToSpline2 a single segment spline, for the arc length
+func (b BezierQuadratic2) ToSpline2() Spline2 {
+	return Spline2FromSegments([]BezierCubic2{b.ToCubic()})
+}
+
+// BezierCubic2 same as BezierCubic, on the XZ plane through X0Y
+type BezierCubic2 struct {
+	P0 Vector2 `json:"p0"`
+	P1 Vector2 `json:"p1"`
+	P2 Vector2 `json:"p2"`
+	P3 Vector2 `json:"p3"`
+}
+
+func (b BezierCubic2) _X0Y() BezierCubic {
+	return BezierCubic{b.P0.X0Y(), b.P1.X0Y(), b.P2.X0Y(), b.P3.X0Y()}
+}
+
+func _BezierCubic2FromXZ(b BezierCubic) BezierCubic2 {
+	return BezierCubic2{b.P0.XZ(), b.P1.XZ(), b.P2.XZ(), b.P3.XZ()}
+}
+
+// Evaluate t [0,1]
+func (b BezierCubic2) Evaluate(t float32) Vector2 {
+	return b._X0Y().Evaluate(t).XZ()
+}
+
+func (b BezierCubic2) Derivative(t float32) Vector2 {
+	return b._X0Y().Derivative(t).XZ()
+}
+
+func (b BezierCubic2) SecondDerivative(t float32) Vector2 {
+	return b._X0Y().SecondDerivative(t).XZ()
+}
+
+// Split at t, return [0,t] and [t,1]
+func (b BezierCubic2) Split(t float32) (BezierCubic2, BezierCubic2) {
+	left, right := b._X0Y().Split(t)
+	return _BezierCubic2FromXZ(left), _BezierCubic2FromXZ(right)
+}
+
+// ClosestPoint return t [0,1] and the point
+func (b BezierCubic2) ClosestPoint(point Vector2) (float32, Vector2) {
+	t, p := b._X0Y().ClosestPoint(point.X0Y())
+	return t, p.XZ()
+}
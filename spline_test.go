@@ -0,0 +1,106 @@
+package gmath
+
+import "testing"
+
+func TestSpline(t *testing.T) {
+	points := []Vector3{{0, 0, 0}, {1, 0, 5}, {6, 0, 6}, {7, 2, 0}, {12, 0, 1}}
+	spline := Spline3FromCatmullRom(points, false)
+	if spline.SegmentCount() != 4 {
+		t.Error("Spline3FromCatmullRom")
+	}
+	for i := 0; i < spline.SegmentCount(); i++ {
+		if !spline.Segment(i).P0.Equal(points[i]) || !spline.Segment(i).P3.Equal(points[i+1]) {
+			t.Error("Spline3FromCatmullRom")
+		}
+	}
+
+	// C1 at the joints
+	for i := 1; i < spline.SegmentCount(); i++ {
+		knot := spline.knots[i]
+		d0 := spline.Derivative(knot - 1e-5)
+		d1 := spline.Derivative(knot + 1e-5)
+		if V3Distance(d0, d1) > d0.Magnitude()*1e-3 {
+			t.Error("Spline3FromCatmullRom C1", i, d0, d1)
+		}
+	}
+
+	// constant speed
+	length := spline.Length()
+	for i := 1; i < 10; i++ {
+		d := length * float32(i) / 10
+		p0 := spline.EvaluateAtDistance(d - 0.1)
+		p1 := spline.EvaluateAtDistance(d + 0.1)
+		if !F32Equal2(V3Distance(p0, p1), 0.2, 1e-2) {
+			t.Error("EvaluateAtDistance", i)
+		}
+	}
+
+	near := spline.Evaluate(0.3)
+	near.AddSelf(Vector3{0, 0.1, 0})
+	tt, p := spline.ClosestPoint(near)
+	if !F32Equal2(tt, 0.3, 1e-3) || !p.Equal(spline.Evaluate(tt)) {
+		t.Error("ClosestPoint", tt)
+	}
+
+	left, right := spline.Split(0.3)
+	if !left.Evaluate(0.5).Equal(spline.Evaluate(0.15)) || !right.Evaluate(0).Equal(spline.Evaluate(0.3)) {
+		t.Error("Split")
+	}
+	sub := spline.Subdivide()
+	if sub.SegmentCount() != 8 || !sub.Evaluate(0.7).Equal(spline.Evaluate(0.7)) || !sub.Derivative(0.7).Equal(spline.Derivative(0.7)) {
+		t.Error("Subdivide")
+	}
+
+	bspline := Spline2FromBSpline([]Vector2{{0, 0}, {1, 5}, {6, 6}, {7, 0}}, false)
+	if !bspline.Evaluate(0).Equal(Vector2{0, 0}) || !bspline.Evaluate(1).Equal(Vector2{7, 0}) {
+		t.Error("Spline2FromBSpline")
+	}
+
+	quadratic := BezierQuadratic{V3Zero(), Vector3{1, 2, 0}, Vector3{2, 0, 0}}
+	if !quadratic.ToCubic().Evaluate(0.3).Equal(quadratic.Evaluate(0.3)) || !quadratic.ToCubic().Derivative(0.3).Equal(quadratic.Derivative(0.3)) {
+		t.Error("BezierQuadratic.ToCubic")
+	}
+
+	quadratic2 := BezierQuadratic2{Vector2{0, 0}, Vector2{1, 2}, Vector2{2, 0}}
+	if !quadratic2.Evaluate(0.5).Equal(Vector2{1, 1}) || !quadratic2.Derivative(0.5).Equal(Vector2{2, 0}) {
+		t.Error("BezierQuadratic2")
+	}
+	left2, right2 := quadratic2.Split(0.3)
+	if !left2.Evaluate(1).Equal(quadratic2.Evaluate(0.3)) || !right2.Evaluate(0.5).Equal(quadratic2.Evaluate(0.65)) {
+		t.Error("BezierQuadratic2.Split")
+	}
+	if tt, p := quadratic2.ClosestPoint(Vector2{1, 1.5}); !F32Equal2(tt, 0.5, 1e-3) || !p.Equal(Vector2{1, 1}) {
+		t.Error("BezierQuadratic2.ClosestPoint")
+	}
+	quadraticSpline := quadratic2.ToSpline2()
+	if !quadraticSpline.Evaluate(0.3).Equal(quadratic2.Evaluate(0.3)) || quadraticSpline.Length() <= 2 {
+		t.Error("BezierQuadratic2.ToSpline2")
+	}
+
+	cubic2 := BezierCubic2{Vector2{0, 0}, Vector2{0, 3}, Vector2{3, 3}, Vector2{3, 0}}
+	if !cubic2.Evaluate(0.5).Equal(Vector2{1.5, 2.25}) || !cubic2.Derivative(0).Equal(Vector2{0, 9}) || !cubic2.SecondDerivative(0.5).Equal(Vector2{0, -18}) {
+		t.Error("BezierCubic2")
+	}
+	left3, right3 := cubic2.Split(0.3)
+	if !left3.Evaluate(1).Equal(cubic2.Evaluate(0.3)) || !right3.Evaluate(0.5).Equal(cubic2.Evaluate(0.65)) {
+		t.Error("BezierCubic2.Split")
+	}
+	if tt, p := cubic2.ClosestPoint(Vector2{1.5, 3}); !F32Equal2(tt, 0.5, 1e-3) || !p.Equal(Vector2{1.5, 2.25}) {
+		t.Error("BezierCubic2.ClosestPoint")
+	}
+	spline2 := Spline2FromCatmullRom([]Vector2{{0, 0}, {1, 5}, {6, 6}, {7, 0}}, false)
+	if spline2.SegmentCount() != 3 || !spline2.Segment(1).P0.Equal(Vector2{1, 5}) || !spline2.Segment(1).P3.Equal(Vector2{6, 6}) ||
+		!spline2.Segment(2).Evaluate(1).Equal(spline2.Evaluate(1)) || !spline2.Segment(0).Derivative(0).Equal(spline2.spline.Segment(0).Derivative(0).XZ()) {
+		t.Error("Spline2.Segment")
+	}
+	if !quadratic2.ToCubic().Evaluate(0.3).Equal(quadratic2.Evaluate(0.3)) {
+		t.Error("BezierQuadratic2.ToCubic")
+	}
+
+	if empty := Spline3FromHermite(nil, nil); empty.SegmentCount() != 0 || empty.Length() != 0 {
+		t.Error("Spline3FromHermite empty")
+	}
+	if empty := Spline2FromHermite([]Vector2{{0, 0}, {1, 0}}, nil); empty.SegmentCount() != 0 {
+		t.Error("Spline2FromHermite empty")
+	}
+}
@@ -0,0 +1,260 @@
+package gmath
+
+// Transform scene graph node, same as Unity's Transform
+//
+// The world matrices are cached and rebuilt lazily after the local values of the node or its ancestors change
+type Transform struct {
+	parent   *Transform
+	children []*Transform
+
+	localPosition Vector3
+	localRotation Quaternion
+	localScale    Vector3
+
+	// if a node is dirty, all its descendants are dirty
+	dirty         bool
+	inverseDirty  bool
+	localToWorld  Matrix4
+	worldToLocal  Matrix4
+	worldRotation Quaternion
+}
+
+func NewTransform() *Transform {
+	return &Transform{
+		localRotation: QuaternionIdentity(),
+		localScale:    V3One(),
+		localToWorld:  Matrix4Identity(),
+		worldToLocal:  Matrix4Identity(),
+		worldRotation: QuaternionIdentity(),
+	}
+}
+
+func (t *Transform) _SetDirty() {
+	if t.dirty {
+		return
+	}
+	t.dirty = true
+	t.inverseDirty = true
+	for _, child := range t.children {
+		child._SetDirty()
+	}
+}
+
+func (t *Transform) _Update() {
+	if !t.dirty {
+		return
+	}
+	local := Matrix4TRS(t.localPosition, t.localRotation, t.localScale)
+	if t.parent == nil {
+		t.localToWorld = local
+		t.worldRotation = t.localRotation
+	} else {
+		t.parent._Update()
+		t.localToWorld = t.parent.localToWorld.Multiply(&local)
+		t.worldRotation = t.parent.worldRotation.Multiply(t.localRotation)
+	}
+	t.dirty = false
+}
+
+func (t *Transform) Parent() *Transform {
+	return t.parent
+}
+
+func (t *Transform) ChildCount() int {
+	return len(t.children)
+}
+
+func (t *Transform) Child(index int) *Transform {
+	return t.children[index]
+}
+
+// IsChildOf true if t is parent or a descendant of parent
+func (t *Transform) IsChildOf(parent *Transform) bool {
+	for node := t; node != nil; node = node.parent {
+		if node == parent {
+			return true
+		}
+	}
+	return false
+}
+
+// SetParent parent can be nil, return false if parent is t or one of its descendants
+// If worldPositionStays, the world position, rotation and scale are kept as much as possible
+func (t *Transform) SetParent(parent *Transform, worldPositionStays bool) bool {
+	if parent != nil && parent.IsChildOf(t) {
+		return false
+	}
+	if parent == t.parent {
+		return true
+	}
+
+	var position, scale Vector3
+	var rotation Quaternion
+	if worldPositionStays {
+		position = t.Position()
+		rotation = t.Rotation()
+		scale = t.LossyScale()
+	}
+
+	if t.parent != nil {
+		children := t.parent.children
+		for i, child := range children {
+			if child == t {
+				t.parent.children = append(children[:i], children[i+1:]...)
+				break
+			}
+		}
+	}
+	t.parent = parent
+	if parent != nil {
+		parent.children = append(parent.children, t)
+	}
+
+	if worldPositionStays {
+		t.SetPosition(position)
+		t.SetRotation(rotation)
+		if parent != nil {
+			parentScale := parent.LossyScale()
+			scale = Vector3{_ScaleDivide(scale.X, parentScale.X), _ScaleDivide(scale.Y, parentScale.Y), _ScaleDivide(scale.Z, parentScale.Z)}
+		}
+		t.localScale = scale
+	}
+	t._SetDirty()
+	return true
+}
+
+func _ScaleDivide(scale, parentScale float32) float32 {
+	if F32IsZero(parentScale) {
+		return scale
+	}
+	return scale / parentScale
+}
+
+func (t *Transform) LocalPosition() Vector3 {
+	return t.localPosition
+}
+
+func (t *Transform) SetLocalPosition(v Vector3) {
+	t.localPosition = v
+	t._SetDirty()
+}
+
+func (t *Transform) LocalRotation() Quaternion {
+	return t.localRotation
+}
+
+func (t *Transform) SetLocalRotation(q Quaternion) {
+	q.NormalizeSelf()
+	t.localRotation = q
+	t._SetDirty()
+}
+
+func (t *Transform) LocalScale() Vector3 {
+	return t.localScale
+}
+
+func (t *Transform) SetLocalScale(v Vector3) {
+	t.localScale = v
+	t._SetDirty()
+}
+
+// Position world space
+func (t *Transform) Position() Vector3 {
+	t._Update()
+	return t.localToWorld.GetPosition()
+}
+
+// SetPosition world space
+func (t *Transform) SetPosition(v Vector3) {
+	if t.parent != nil {
+		v = t.parent.InverseTransformPoint(v)
+	}
+	t.SetLocalPosition(v)
+}
+
+// Rotation world space
+func (t *Transform) Rotation() Quaternion {
+	t._Update()
+	return t.worldRotation
+}
+
+// SetRotation world space
+func (t *Transform) SetRotation(q Quaternion) {
+	if t.parent != nil {
+		q = t.parent.Rotation().Inverse().Multiply(q)
+	}
+	t.SetLocalRotation(q)
+}
+
+// LossyScale world space, not accurate if the parents are non-uniformly scaled and rotated
+func (t *Transform) LossyScale() Vector3 {
+	t._Update()
+	return t.localToWorld.GetLossyScale()
+}
+
+func (t *Transform) LocalToWorldMatrix() Matrix4 {
+	t._Update()
+	return t.localToWorld
+}
+
+func (t *Transform) WorldToLocalMatrix() Matrix4 {
+	t._Update()
+	if t.inverseDirty {
+		t.worldToLocal = t.localToWorld.Inverse()
+		t.inverseDirty = false
+	}
+	return t.worldToLocal
+}
+
+func (t *Transform) Forward() Vector3 {
+	return t.Rotation().MultiplyV3(V3Forward())
+}
+
+func (t *Transform) Right() Vector3 {
+	return t.Rotation().MultiplyV3(V3Right())
+}
+
+func (t *Transform) Up() Vector3 {
+	return t.Rotation().MultiplyV3(V3Up())
+}
+
+// TransformPoint local space to world space
+func (t *Transform) TransformPoint(point Vector3) Vector3 {
+	t._Update()
+	return t.localToWorld.MultiplyPoint3x4(point)
+}
+
+// InverseTransformPoint world space to local space
+func (t *Transform) InverseTransformPoint(point Vector3) Vector3 {
+	m := t.WorldToLocalMatrix()
+	return m.MultiplyPoint3x4(point)
+}
+
+// TransformDirection rotation only, the magnitude is kept
+func (t *Transform) TransformDirection(dir Vector3) Vector3 {
+	return t.Rotation().MultiplyV3(dir)
+}
+
+func (t *Transform) InverseTransformDirection(dir Vector3) Vector3 {
+	return t.Rotation().Inverse().MultiplyV3(dir)
+}
+
+// TransformVector affected by rotation and scale, not position
+func (t *Transform) TransformVector(v Vector3) Vector3 {
+	t._Update()
+	return t.localToWorld.MultiplyDir3(v)
+}
+
+func (t *Transform) InverseTransformVector(v Vector3) Vector3 {
+	m := t.WorldToLocalMatrix()
+	return m.MultiplyDir3(v)
+}
+
+// LookAt rotate the forward to target, both in world space
+func (t *Transform) LookAt(target Vector3, worldUp Vector3) {
+	forward := target.Substract(t.Position())
+	if forward.IsZero() {
+		return
+	}
+	t.SetRotation(QuaternionLookRotation(forward, worldUp))
+}
@@ -0,0 +1,53 @@
+package gmath
+
+import "testing"
+
+func TestTransform(t *testing.T) {
+	vehicle := NewTransform()
+	vehicle.SetLocalPosition(Vector3{10, 0, 0})
+	vehicle.SetLocalRotation(QuaternionAngleAxis(PI/2, V3Up()))
+	vehicle.SetLocalScale(Vector3{2, 2, 2})
+
+	turret := NewTransform()
+	turret.SetParent(vehicle, false)
+	turret.SetLocalPosition(Vector3{0, 1, 1})
+
+	if !turret.Position().Equal(Vector3{12, 2, 0}) {
+		t.Error("Position", turret.Position())
+	}
+	if !turret.Forward().Equal(V3Right()) {
+		t.Error("Forward", turret.Forward())
+	}
+	if !turret.InverseTransformPoint(turret.TransformPoint(Vector3{1, 2, 3})).Equal(Vector3{1, 2, 3}) {
+		t.Error("InverseTransformPoint")
+	}
+
+	// dirty propagation to the children
+	vehicle.SetPosition(Vector3{0, 0, 0})
+	if !turret.Position().Equal(Vector3{2, 2, 0}) {
+		t.Error("Position dirty", turret.Position())
+	}
+
+	turret.LookAt(Vector3{2, 2, 10}, V3Up())
+	if !turret.Forward().Equal(V3Forward()) {
+		t.Error("LookAt", turret.Forward())
+	}
+
+	// keep the world values
+	position, rotation := turret.Position(), turret.Rotation()
+	turret.SetParent(nil, true)
+	if !turret.Position().Equal(position) || !turret.Rotation().Equal(rotation) || !turret.LossyScale().Equal(Vector3{2, 2, 2}) {
+		t.Error("SetParent worldPositionStays")
+	}
+	if vehicle.ChildCount() != 0 {
+		t.Error("SetParent children")
+	}
+
+	turret.SetParent(vehicle, true)
+	if !turret.Position().Equal(position) || !turret.LocalScale().Equal(V3One()) {
+		t.Error("SetParent worldPositionStays", turret.Position(), turret.LocalScale())
+	}
+	if vehicle.SetParent(turret, false) {
+		t.Error("SetParent cycle")
+	}
+}
@@ -0,0 +1,79 @@
+package gmath
+
+type Triangle struct {
+	A Vector3 `json:"a"`
+	B Vector3 `json:"b"`
+	C Vector3 `json:"c"`
+}
+
+// Normal clockwise is the front face, same as Unity, zero if degenerate
+func (triangle Triangle) Normal() Vector3 {
+	return triangle.B.Substract(triangle.A).Cross(triangle.C.Substract(triangle.A)).Normalize()
+}
+
+func (triangle Triangle) Area() float32 {
+	return triangle.B.Substract(triangle.A).Cross(triangle.C.Substract(triangle.A)).Magnitude() * 0.5
+}
+
+func (triangle Triangle) Center() Vector3 {
+	sum := triangle.A.Add(triangle.B)
+	sum.AddSelf(triangle.C)
+	return sum.Scale(1.0 / 3)
+}
+
+func (triangle Triangle) Plane() Plane {
+	return PlaneFromPoints(triangle.A, triangle.B, triangle.C)
+}
+
+func (triangle Triangle) GetBounds() Bounds {
+	return BoundsMinMax(V3Min(triangle.A, V3Min(triangle.B, triangle.C)), V3Max(triangle.A, V3Max(triangle.B, triangle.C)))
+}
+
+// GetPoint barycentric (u, v), the point is A*(1-u-v) + B*u + C*v
+func (triangle Triangle) GetPoint(u, v float32) Vector3 {
+	ret := triangle.A.Scale(1 - u - v)
+	ret.AddSelf(triangle.B.Scale(u))
+	ret.AddSelf(triangle.C.Scale(v))
+	return ret
+}
+
+func (triangle Triangle) Transform(matrix *Matrix4) Triangle {
+	return Triangle{matrix.MultiplyPoint3x4(triangle.A), matrix.MultiplyPoint3x4(triangle.B), matrix.MultiplyPoint3x4(triangle.C)}
+}
+
+// Raycast both faces can be hit
+func (triangle Triangle) Raycast(ray Ray) (RaycastHit, bool) {
+	hit, _, _, ok := triangle.RaycastBarycentric(ray)
+	return hit, ok
+}
+
+// RaycastBarycentric Moller-Trumbore, also returns the barycentric (u, v) of the hit point, see GetPoint
+func (triangle Triangle) RaycastBarycentric(ray Ray) (hit RaycastHit, u float32, v float32, ok bool) {
+	edge1 := triangle.B.Substract(triangle.A)
+	edge2 := triangle.C.Substract(triangle.A)
+	p := ray.Direction.Cross(edge2)
+	det := edge1.Dot(p)
+	if F32IsZero2(det, 1e-12) {
+		return RaycastHit{}, 0, 0, false
+	}
+	inv := 1 / det
+	offset := ray.Origin.Substract(triangle.A)
+	u = offset.Dot(p) * inv
+	if u < 0 || u > 1 {
+		return RaycastHit{}, 0, 0, false
+	}
+	q := offset.Cross(edge1)
+	v = ray.Direction.Dot(q) * inv
+	if v < 0 || u+v > 1 {
+		return RaycastHit{}, 0, 0, false
+	}
+	distance := edge2.Dot(q) * inv
+	if distance < 0 {
+		return RaycastHit{}, 0, 0, false
+	}
+	normal := edge1.Cross(edge2).Normalize()
+	if normal.Dot(ray.Direction) > 0 {
+		normal = normal.Scale(-1)
+	}
+	return _NewRaycastHit(ray, distance, normal), u, v, true
+}
@@ -7,8 +7,12 @@ type Vector2 struct {
 	Y float32 `json:"y"`
 }
 
-func V2Zero() Vector2 { return Vector2{0, 0} }
-func V2One() Vector2  { return Vector2{1, 1} }
+func V2Zero() Vector2  { return Vector2{0, 0} }
+func V2One() Vector2   { return Vector2{1, 1} }
+func V2Up() Vector2    { return Vector2{0, 1} }
+func V2Down() Vector2  { return Vector2{0, -1} }
+func V2Left() Vector2  { return Vector2{-1, 0} }
+func V2Right() Vector2 { return Vector2{1, 0} }
 
 func (v2 *Vector2) Set(x, y float32) {
 	v2.X = x
@@ -27,6 +31,11 @@ func (v2 *Vector2) Add(v Vector2) Vector2 {
 	return Vector2{v2.X + v.X, v2.Y + v.Y}
 }
 
+func (v2 *Vector2) AddSelf(v Vector2) {
+	v2.X += v.X
+	v2.Y += v.Y
+}
+
 func (v2 Vector2) Substract(v Vector2) Vector2 {
 	return Vector2{v2.X - v.X, v2.Y - v.Y}
 }
@@ -44,6 +53,27 @@ func (v2 Vector2) ScaleV2(v Vector2) Vector2 {
 	return Vector2{v2.X * v.X, v2.Y * v.Y}
 }
 
+// Cross the Y of v2.X0Y().Cross(v.X0Y()), > 0 if v is clockwise from v2
+func (v2 Vector2) Cross(v Vector2) float32 {
+	return v2.Y*v.X - v2.X*v.Y
+}
+
+// Perpendicular rotate 90 degree clockwise, V2Up() => V2Right()
+func (v2 Vector2) Perpendicular() Vector2 {
+	return Vector2{v2.Y, -v2.X}
+}
+
+// Rotate clockwise, same as rotating X0Y() around V3Up()
+func (v2 Vector2) Rotate(angle AngleRadian) Vector2 {
+	sin := Sin(angle)
+	cos := Cos(angle)
+	return Vector2{v2.X*cos + v2.Y*sin, v2.Y*cos - v2.X*sin}
+}
+
+func (v2 Vector2) RotateDegree(angle AngleDegree) Vector2 {
+	return v2.Rotate(angle.ToRadian())
+}
+
 func (v2 Vector2) Magnitude() float32 {
 	return F32Sqrt(v2.X*v2.X + v2.Y*v2.Y)
 }
@@ -71,10 +101,113 @@ func (v2 Vector2) IsZero() bool {
 	return F32IsZero(v2.SqrMagnitude())
 }
 
-func (v2 Vector2) Equal(v Vector3) bool {
+func (v2 Vector2) Equal(v Vector2) bool {
 	return F32Equal(v2.X, v.X) && F32Equal(v2.Y, v.Y)
 }
 
 func (v2 Vector2) IsValid() bool {
 	return !(math.IsNaN(float64(v2.X)) || math.IsNaN(float64(v2.Y)))
 }
+
+//========================
+
+func V2Lerp(from Vector2, to Vector2, t float32) Vector2 {
+	t = F32Clamp01(t)
+	return Vector2{from.X + (to.X-from.X)*t, from.Y + (to.Y-from.Y)*t}
+}
+
+func V2LerpUnclamped(from Vector2, to Vector2, t float32) Vector2 {
+	return Vector2{from.X + (to.X-from.X)*t, from.Y + (to.Y-from.Y)*t}
+}
+
+func V2MoveTowards(current Vector2, target Vector2, maxDistanceDelta float32) Vector2 {
+	num := target.X - current.X
+	num2 := target.Y - current.Y
+	num3 := num*num + num2*num2
+
+	if num3 == 0 || (maxDistanceDelta >= 0 && num3 <= maxDistanceDelta*maxDistanceDelta) {
+		return target
+	}
+	num4 := F32Sqrt(num3)
+	return Vector2{current.X + num/num4*maxDistanceDelta, current.Y + num2/num4*maxDistanceDelta}
+}
+
+// V2Angle [0,180]
+func V2Angle(from Vector2, to Vector2) AngleDegree {
+	num := from.SqrMagnitude() * to.SqrMagnitude()
+	if num < 1e-7 {
+		return 0
+	}
+	num = F32Sqrt(num)
+
+	num2 := F32Clamp(from.Dot(to)/num, -1, 1)
+	return Acos(num2).ToDegrees()
+}
+
+// V2SignedAngle [-180,180], clockwise is positive, same as V3SignedAngleY(from.X0Y(), to.X0Y())
+func V2SignedAngle(from, to Vector2) AngleDegree {
+	num := V2Angle(from, to)
+	num2 := F32Sign(from.Cross(to))
+	return num * AngleDegree(num2)
+}
+
+func V2Min(a, b Vector2) Vector2 {
+	return Vector2{F32Min(a.X, b.X), F32Min(a.Y, b.Y)}
+}
+
+func V2Max(a, b Vector2) Vector2 {
+	return Vector2{F32Max(a.X, b.X), F32Max(a.Y, b.Y)}
+}
+
+func V2Distance(from, to Vector2) float32 {
+	return to.Substract(from).Magnitude()
+}
+
+func V2DistanceSqr(from, to Vector2) float32 {
+	return to.Substract(from).SqrMagnitude()
+}
+
+func V2ClampMagnitude(vector Vector2, maxLength float32) Vector2 {
+	sqrMagnitude := vector.SqrMagnitude()
+	if sqrMagnitude <= maxLength*maxLength {
+		return vector
+	}
+	num := maxLength / F32Sqrt(sqrMagnitude)
+	return Vector2{vector.X * num, vector.Y * num}
+}
+
+func V2Reflect(inDirection Vector2, inNormal Vector2) Vector2 {
+	num := -2 * inNormal.Dot(inDirection)
+	return Vector2{num*inNormal.X + inDirection.X, num*inNormal.Y + inDirection.Y}
+}
+
+// V2SmoothDamp critically damped spring, currentVelocity is updated in place
+func V2SmoothDamp(current Vector2, target Vector2, currentVelocity *Vector2, smoothTime float32, maxSpeed float32, deltaTime float32) Vector2 {
+	if deltaTime <= 0 {
+		return current
+	}
+	smoothTime = F32Max(0.0001, smoothTime)
+	omega := 2 / smoothTime
+	x := omega * deltaTime
+	exp := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	originalTo := target
+	change := V2ClampMagnitude(current.Substract(target), maxSpeed*smoothTime)
+	target = current.Substract(change)
+
+	temp := currentVelocity.Add(change.Scale(omega)).Scale(deltaTime)
+	*currentVelocity = currentVelocity.Substract(temp.Scale(omega)).Scale(exp)
+	ret := target.Add(change.Add(temp).Scale(exp))
+
+	// prevent overshooting
+	if originalTo.Substract(current).Dot(ret.Substract(originalTo)) > 0 {
+		ret = originalTo
+		*currentVelocity = V2Zero()
+	}
+	return ret
+}
+
+// V2Damp exponential decay towards target
+func V2Damp(current Vector2, target Vector2, halfLife float32, deltaTime float32) Vector2 {
+	return V2LerpUnclamped(current, target, F32DampFactor(halfLife, deltaTime))
+}
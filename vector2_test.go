@@ -0,0 +1,45 @@
+package gmath
+
+import "testing"
+
+func TestVector2(t *testing.T) {
+	a := V2Angle(V2Up(), V2Down())
+	if a != 180 {
+		t.Error("V2Angle")
+	}
+
+	a = V2SignedAngle(V2Up(), Vector2{3, 3}.Normalize())
+	if a != V3SignedAngleY(V3Forward(), Vector3{3, 0, 3}.Normalize()) {
+		t.Error("V2SignedAngle")
+	}
+
+	a = V2SignedAngle(V2Up(), Vector2{-3, 3}.Normalize())
+	if a != -45 {
+		t.Error("V2SignedAngle")
+	}
+
+	v := Vector2{1, 2}.RotateDegree(30)
+	v3 := QuaternionAngleAxis(AngleDegree(30).ToRadian(), V3Up()).MultiplyV3(Vector3{1, 0, 2})
+	if !v.Equal(v3.XZ()) {
+		t.Error("RotateDegree")
+	}
+
+	if !V2Up().Perpendicular().Equal(V2Right()) {
+		t.Error("Perpendicular")
+	}
+
+	v = V2MoveTowards(V2Zero(), Vector2{3, 4}, 2)
+	if !v.Equal(Vector2{1.2, 1.6}) {
+		t.Error("V2MoveTowards")
+	}
+
+	v = V2ClampMagnitude(Vector2{3, 4}, 1)
+	if !v.Equal(Vector2{0.6, 0.8}) {
+		t.Error("V2ClampMagnitude")
+	}
+
+	v = V2Reflect(Vector2{1, -1}, V2Up())
+	if !v.Equal(Vector2{1, 1}) {
+		t.Error("V2Reflect")
+	}
+}
@@ -0,0 +1,155 @@
+package gmath
+
+import "image"
+
+type Vector2Int struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func V2IntZero() Vector2Int  { return Vector2Int{0, 0} }
+func V2IntOne() Vector2Int   { return Vector2Int{1, 1} }
+func V2IntUp() Vector2Int    { return Vector2Int{0, 1} }
+func V2IntDown() Vector2Int  { return Vector2Int{0, -1} }
+func V2IntLeft() Vector2Int  { return Vector2Int{-1, 0} }
+func V2IntRight() Vector2Int { return Vector2Int{1, 0} }
+
+func V2IntFromPoint(p image.Point) Vector2Int {
+	return Vector2Int{p.X, p.Y}
+}
+
+// V2FloorToInt the cell which contains v
+func V2FloorToInt(v Vector2) Vector2Int {
+	return Vector2Int{F32FloorToInt(v.X), F32FloorToInt(v.Y)}
+}
+
+func V2RoundToInt(v Vector2) Vector2Int {
+	return Vector2Int{F32RoundToInt(v.X), F32RoundToInt(v.Y)}
+}
+
+func V2CeilToInt(v Vector2) Vector2Int {
+	return Vector2Int{F32CeilToInt(v.X), F32CeilToInt(v.Y)}
+}
+
+func (v2 *Vector2Int) Set(x, y int) {
+	v2.X = x
+	v2.Y = y
+}
+
+func (v2 Vector2Int) ToVector2() Vector2 {
+	return Vector2{float32(v2.X), float32(v2.Y)}
+}
+
+func (v2 Vector2Int) ToPoint() image.Point {
+	return image.Point{v2.X, v2.Y}
+}
+
+func (v2 Vector2Int) X0Y() Vector3Int {
+	return Vector3Int{v2.X, 0, v2.Y}
+}
+
+func (v2 Vector2Int) Add(v Vector2Int) Vector2Int {
+	return Vector2Int{v2.X + v.X, v2.Y + v.Y}
+}
+
+func (v2 *Vector2Int) AddSelf(v Vector2Int) {
+	v2.X += v.X
+	v2.Y += v.Y
+}
+
+func (v2 Vector2Int) Substract(v Vector2Int) Vector2Int {
+	return Vector2Int{v2.X - v.X, v2.Y - v.Y}
+}
+
+func (v2 Vector2Int) Scale(v int) Vector2Int {
+	return Vector2Int{v2.X * v, v2.Y * v}
+}
+
+func (v2 Vector2Int) ScaleV2Int(v Vector2Int) Vector2Int {
+	return Vector2Int{v2.X * v.X, v2.Y * v.Y}
+}
+
+func (v2 Vector2Int) Magnitude() float32 {
+	return F32Sqrt(float32(v2.SqrMagnitude()))
+}
+
+func (v2 Vector2Int) SqrMagnitude() int {
+	return v2.X*v2.X + v2.Y*v2.Y
+}
+
+func (v2 Vector2Int) IsZero() bool {
+	return v2.X == 0 && v2.Y == 0
+}
+
+func (v2 Vector2Int) Equal(v Vector2Int) bool {
+	return v2 == v
+}
+
+// Neighbors4 up, right, down, left
+func (v2 Vector2Int) Neighbors4() [4]Vector2Int {
+	return [4]Vector2Int{
+		{v2.X, v2.Y + 1},
+		{v2.X + 1, v2.Y},
+		{v2.X, v2.Y - 1},
+		{v2.X - 1, v2.Y},
+	}
+}
+
+// Neighbors8 clockwise, start from up
+func (v2 Vector2Int) Neighbors8() [8]Vector2Int {
+	return [8]Vector2Int{
+		{v2.X, v2.Y + 1},
+		{v2.X + 1, v2.Y + 1},
+		{v2.X + 1, v2.Y},
+		{v2.X + 1, v2.Y - 1},
+		{v2.X, v2.Y - 1},
+		{v2.X - 1, v2.Y - 1},
+		{v2.X - 1, v2.Y},
+		{v2.X - 1, v2.Y + 1},
+	}
+}
+
+//========================
+
+func V2IntMin(a, b Vector2Int) Vector2Int {
+	return Vector2Int{_IntMin(a.X, b.X), _IntMin(a.Y, b.Y)}
+}
+
+func V2IntMax(a, b Vector2Int) Vector2Int {
+	return Vector2Int{_IntMax(a.X, b.X), _IntMax(a.Y, b.Y)}
+}
+
+func V2IntDistance(from, to Vector2Int) float32 {
+	return to.Substract(from).Magnitude()
+}
+
+// V2IntManhattanDistance |dx| + |dy|
+func V2IntManhattanDistance(from, to Vector2Int) int {
+	return _IntAbs(to.X-from.X) + _IntAbs(to.Y-from.Y)
+}
+
+// V2IntChebyshevDistance max(|dx|, |dy|)
+func V2IntChebyshevDistance(from, to Vector2Int) int {
+	return _IntMax(_IntAbs(to.X-from.X), _IntAbs(to.Y-from.Y))
+}
+
+func _IntAbs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func _IntMin(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func _IntMax(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
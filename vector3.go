@@ -173,3 +173,156 @@ func V3DistanceXZ(from, to Vector3) float32 {
 func V3DistanceXZSqr(from, to Vector3) float32 {
 	return to.Substract(from).XZ().SqrMagnitude()
 }
+
+func V3Min(a, b Vector3) Vector3 {
+	return Vector3{F32Min(a.X, b.X), F32Min(a.Y, b.Y), F32Min(a.Z, b.Z)}
+}
+
+func V3Max(a, b Vector3) Vector3 {
+	return Vector3{F32Max(a.X, b.X), F32Max(a.Y, b.Y), F32Max(a.Z, b.Z)}
+}
+
+func V3ClampMagnitude(vector Vector3, maxLength float32) Vector3 {
+	sqrMagnitude := vector.SqrMagnitude()
+	if sqrMagnitude <= maxLength*maxLength {
+		return vector
+	}
+	num := maxLength / F32Sqrt(sqrMagnitude)
+	return Vector3{vector.X * num, vector.Y * num, vector.Z * num}
+}
+
+// V3Project return zero if onNormal is zero
+func V3Project(vector Vector3, onNormal Vector3) Vector3 {
+	num := onNormal.SqrMagnitude()
+	if num < 1e-15 {
+		return V3Zero()
+	}
+	return onNormal.Scale(vector.Dot(onNormal) / num)
+}
+
+// V3ProjectOnPlane return vector if planeNormal is zero
+func V3ProjectOnPlane(vector Vector3, planeNormal Vector3) Vector3 {
+	num := planeNormal.SqrMagnitude()
+	if num < 1e-15 {
+		return vector
+	}
+	return vector.Substract(planeNormal.Scale(vector.Dot(planeNormal) / num))
+}
+
+func V3Reflect(inDirection Vector3, inNormal Vector3) Vector3 {
+	num := -2 * inNormal.Dot(inDirection)
+	return Vector3{num*inNormal.X + inDirection.X, num*inNormal.Y + inDirection.Y, num*inNormal.Z + inDirection.Z}
+}
+
+// V3Slerp the direction is interpolated by angle, and the magnitude is interpolated linearly
+func V3Slerp(from Vector3, to Vector3, t float32) Vector3 {
+	return V3SlerpUnclamped(from, to, F32Clamp01(t))
+}
+
+func V3SlerpUnclamped(from Vector3, to Vector3, t float32) Vector3 {
+	fromMagnitude := from.Magnitude()
+	toMagnitude := to.Magnitude()
+	if F32IsZero(fromMagnitude) || F32IsZero(toMagnitude) {
+		return V3LerpUnclamped(from, to, t)
+	}
+
+	fromDir := from.Scale(1 / fromMagnitude)
+	toDir := to.Scale(1 / toMagnitude)
+	magnitude := F32LerpUnclamped(fromMagnitude, toMagnitude, t)
+
+	cos_theta := F32Clamp(fromDir.Dot(toDir), -1, 1)
+	if cos_theta > 1-Epsilon {
+		return V3LerpUnclamped(fromDir, toDir, t).Normalize().Scale(magnitude)
+	}
+
+	theta := Acos(cos_theta)
+	var axis Vector3
+	if cos_theta < -1+Epsilon {
+		axis = _V3AnyPerpendicular(fromDir)
+	} else {
+		axis = fromDir.Cross(toDir)
+	}
+	dir := QuaternionAngleAxis(theta.Multiply(t), axis).MultiplyV3(fromDir)
+	return dir.Scale(magnitude)
+}
+
+// V3RotateTowards rotate current towards target by maxRadiansDelta, and move the magnitude by maxMagnitudeDelta
+func V3RotateTowards(current Vector3, target Vector3, maxRadiansDelta AngleRadian, maxMagnitudeDelta float32) Vector3 {
+	currentMagnitude := current.Magnitude()
+	targetMagnitude := target.Magnitude()
+	if F32IsZero(currentMagnitude) || F32IsZero(targetMagnitude) {
+		return V3MoveTowards(current, target, maxMagnitudeDelta)
+	}
+
+	currentDir := current.Scale(1 / currentMagnitude)
+	targetDir := target.Scale(1 / targetMagnitude)
+	magnitude := F32MoveTowards(currentMagnitude, targetMagnitude, maxMagnitudeDelta)
+
+	angle := Acos(currentDir.Dot(targetDir))
+	if F32IsZero(angle.ToFloat32()) {
+		return targetDir.Scale(magnitude)
+	}
+	t := F32Min(1, maxRadiansDelta.ToFloat32()/angle.ToFloat32())
+	return V3SlerpUnclamped(currentDir, targetDir, t).Scale(magnitude)
+}
+
+// V3OrthoNormalize normalize normal, and make tangent normalized and orthogonal to normal
+func V3OrthoNormalize(normal *Vector3, tangent *Vector3) {
+	if normal.NormalizeSelf() == 0 {
+		*normal = V3Forward()
+	}
+	*tangent = V3ProjectOnPlane(*tangent, *normal)
+	if tangent.NormalizeSelf() == 0 {
+		*tangent = _V3AnyPerpendicular(*normal)
+	}
+}
+
+// V3OrthoNormalize3 same as V3OrthoNormalize, and make binormal normalized and orthogonal to both
+func V3OrthoNormalize3(normal *Vector3, tangent *Vector3, binormal *Vector3) {
+	V3OrthoNormalize(normal, tangent)
+	dir := normal.Cross(*tangent)
+	if binormal.Dot(dir) < 0 {
+		dir = dir.Scale(-1)
+	}
+	*binormal = dir
+}
+
+// _V3AnyPerpendicular normalized, v must not be zero
+func _V3AnyPerpendicular(v Vector3) Vector3 {
+	ret := v.Cross(V3Right())
+	if ret.SqrMagnitude() < 1e-4*v.SqrMagnitude() {
+		ret = v.Cross(V3Forward())
+	}
+	return ret.Normalize()
+}
+
+// V3SmoothDamp critically damped spring, currentVelocity is updated in place
+func V3SmoothDamp(current Vector3, target Vector3, currentVelocity *Vector3, smoothTime float32, maxSpeed float32, deltaTime float32) Vector3 {
+	if deltaTime <= 0 {
+		return current
+	}
+	smoothTime = F32Max(0.0001, smoothTime)
+	omega := 2 / smoothTime
+	x := omega * deltaTime
+	exp := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	originalTo := target
+	change := V3ClampMagnitude(current.Substract(target), maxSpeed*smoothTime)
+	target = current.Substract(change)
+
+	temp := currentVelocity.Add(change.Scale(omega)).Scale(deltaTime)
+	*currentVelocity = currentVelocity.Substract(temp.Scale(omega)).Scale(exp)
+	ret := target.Add(change.Add(temp).Scale(exp))
+
+	// prevent overshooting
+	if originalTo.Substract(current).Dot(ret.Substract(originalTo)) > 0 {
+		ret = originalTo
+		*currentVelocity = V3Zero()
+	}
+	return ret
+}
+
+// V3Damp exponential decay towards target
+func V3Damp(current Vector3, target Vector3, halfLife float32, deltaTime float32) Vector3 {
+	return V3LerpUnclamped(current, target, F32DampFactor(halfLife, deltaTime))
+}
@@ -22,4 +22,58 @@ func TestVector3(t *testing.T) {
 	if a != -45 {
 		t.Error("V3SignedAngle")
 	}
+
+	v := V3ProjectOnPlane(Vector3{1, 2, 3}, V3Up())
+	if !v.Equal(Vector3{1, 0, 3}) || !V3Project(Vector3{1, 2, 3}, V3Up().Scale(2)).Equal(Vector3{0, 2, 0}) {
+		t.Error("V3Project")
+	}
+
+	v = V3Reflect(Vector3{1, -1, 0}, V3Up())
+	if !v.Equal(Vector3{1, 1, 0}) {
+		t.Error("V3Reflect")
+	}
+
+	v = V3Slerp(V3Forward(), V3Right().Scale(3), 0.5)
+	if !v.Equal(Vector3{1, 0, 1}.Normalize().Scale(2)) {
+		t.Error("V3Slerp")
+	}
+
+	v = V3Slerp(V3Forward(), V3Back(), 0.5)
+	if !F32Equal(v.Magnitude(), 1) || !F32Equal(v.Dot(V3Forward()), 0) {
+		t.Error("V3Slerp opposite")
+	}
+
+	v = V3RotateTowards(V3Forward(), V3Right().Scale(2), AngleDegree(30).ToRadian(), 0.5)
+	if !F32Equal(float32(V3SignedAngleY(V3Forward(), v)), 30) || !F32Equal(v.Magnitude(), 1.5) {
+		t.Error("V3RotateTowards")
+	}
+
+	normal, tangent, binormal := Vector3{0, 2, 0}, Vector3{1, 1, 0}, Vector3{0, 0, -5}
+	V3OrthoNormalize3(&normal, &tangent, &binormal)
+	if !normal.Equal(V3Up()) || !tangent.Equal(V3Right()) || !binormal.Equal(V3Back()) {
+		t.Error("V3OrthoNormalize3")
+	}
+
+	// same result for 1 frame of 0.2s and 4 frames of 0.05s
+	v1 := V3Damp(V3Zero(), Vector3{10, 0, 0}, 0.5, 0.2)
+	v2 := V3Zero()
+	for i := 0; i < 4; i++ {
+		v2 = V3Damp(v2, Vector3{10, 0, 0}, 0.5, 0.05)
+	}
+	if !F32Equal2(v1.X, v2.X, 1e-4) {
+		t.Error("V3Damp")
+	}
+
+	velocity := V3Zero()
+	v = V3Zero()
+	for i := 0; i < 200; i++ {
+		v = V3SmoothDamp(v, Vector3{10, 0, 10}, &velocity, 0.5, 5, 0.05)
+		if velocity.Magnitude() > 5.001 {
+			t.Error("V3SmoothDamp maxSpeed")
+			break
+		}
+	}
+	if !v.Equal(Vector3{10, 0, 10}) {
+		t.Error("V3SmoothDamp")
+	}
 }
@@ -0,0 +1,135 @@
+package gmath
+
+type Vector3Int struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	Z int `json:"z"`
+}
+
+func V3IntZero() Vector3Int    { return Vector3Int{0, 0, 0} }
+func V3IntOne() Vector3Int     { return Vector3Int{1, 1, 1} }
+func V3IntUp() Vector3Int      { return Vector3Int{0, 1, 0} }
+func V3IntDown() Vector3Int    { return Vector3Int{0, -1, 0} }
+func V3IntLeft() Vector3Int    { return Vector3Int{-1, 0, 0} }
+func V3IntRight() Vector3Int   { return Vector3Int{1, 0, 0} }
+func V3IntForward() Vector3Int { return Vector3Int{0, 0, 1} }
+func V3IntBack() Vector3Int    { return Vector3Int{0, 0, -1} }
+
+// V3FloorToInt the cell which contains v
+func V3FloorToInt(v Vector3) Vector3Int {
+	return Vector3Int{F32FloorToInt(v.X), F32FloorToInt(v.Y), F32FloorToInt(v.Z)}
+}
+
+func V3RoundToInt(v Vector3) Vector3Int {
+	return Vector3Int{F32RoundToInt(v.X), F32RoundToInt(v.Y), F32RoundToInt(v.Z)}
+}
+
+func V3CeilToInt(v Vector3) Vector3Int {
+	return Vector3Int{F32CeilToInt(v.X), F32CeilToInt(v.Y), F32CeilToInt(v.Z)}
+}
+
+func (v3 *Vector3Int) Set(x, y, z int) {
+	v3.X = x
+	v3.Y = y
+	v3.Z = z
+}
+
+func (v3 Vector3Int) ToVector3() Vector3 {
+	return Vector3{float32(v3.X), float32(v3.Y), float32(v3.Z)}
+}
+
+func (v3 Vector3Int) XZ() Vector2Int {
+	return Vector2Int{v3.X, v3.Z}
+}
+
+func (v3 Vector3Int) Add(v Vector3Int) Vector3Int {
+	return Vector3Int{v3.X + v.X, v3.Y + v.Y, v3.Z + v.Z}
+}
+
+func (v3 *Vector3Int) AddSelf(v Vector3Int) {
+	v3.X += v.X
+	v3.Y += v.Y
+	v3.Z += v.Z
+}
+
+func (v3 Vector3Int) Substract(v Vector3Int) Vector3Int {
+	return Vector3Int{v3.X - v.X, v3.Y - v.Y, v3.Z - v.Z}
+}
+
+func (v3 Vector3Int) Scale(v int) Vector3Int {
+	return Vector3Int{v3.X * v, v3.Y * v, v3.Z * v}
+}
+
+func (v3 Vector3Int) ScaleV3Int(v Vector3Int) Vector3Int {
+	return Vector3Int{v3.X * v.X, v3.Y * v.Y, v3.Z * v.Z}
+}
+
+func (v3 Vector3Int) Magnitude() float32 {
+	return F32Sqrt(float32(v3.SqrMagnitude()))
+}
+
+func (v3 Vector3Int) SqrMagnitude() int {
+	return v3.X*v3.X + v3.Y*v3.Y + v3.Z*v3.Z
+}
+
+func (v3 Vector3Int) IsZero() bool {
+	return v3.X == 0 && v3.Y == 0 && v3.Z == 0
+}
+
+func (v3 Vector3Int) Equal(v Vector3Int) bool {
+	return v3 == v
+}
+
+// Neighbors6 right, left, up, down, forward, back
+func (v3 Vector3Int) Neighbors6() [6]Vector3Int {
+	return [6]Vector3Int{
+		{v3.X + 1, v3.Y, v3.Z},
+		{v3.X - 1, v3.Y, v3.Z},
+		{v3.X, v3.Y + 1, v3.Z},
+		{v3.X, v3.Y - 1, v3.Z},
+		{v3.X, v3.Y, v3.Z + 1},
+		{v3.X, v3.Y, v3.Z - 1},
+	}
+}
+
+// Neighbors26 all cells of the 3x3x3 cube except v3, ordered by x, y, z
+func (v3 Vector3Int) Neighbors26() [26]Vector3Int {
+	var ret [26]Vector3Int
+	index := 0
+	for x := -1; x <= 1; x++ {
+		for y := -1; y <= 1; y++ {
+			for z := -1; z <= 1; z++ {
+				if x == 0 && y == 0 && z == 0 {
+					continue
+				}
+				ret[index] = Vector3Int{v3.X + x, v3.Y + y, v3.Z + z}
+				index++
+			}
+		}
+	}
+	return ret
+}
+
+//========================
+
+func V3IntMin(a, b Vector3Int) Vector3Int {
+	return Vector3Int{_IntMin(a.X, b.X), _IntMin(a.Y, b.Y), _IntMin(a.Z, b.Z)}
+}
+
+func V3IntMax(a, b Vector3Int) Vector3Int {
+	return Vector3Int{_IntMax(a.X, b.X), _IntMax(a.Y, b.Y), _IntMax(a.Z, b.Z)}
+}
+
+func V3IntDistance(from, to Vector3Int) float32 {
+	return to.Substract(from).Magnitude()
+}
+
+// V3IntManhattanDistance |dx| + |dy| + |dz|
+func V3IntManhattanDistance(from, to Vector3Int) int {
+	return _IntAbs(to.X-from.X) + _IntAbs(to.Y-from.Y) + _IntAbs(to.Z-from.Z)
+}
+
+// V3IntChebyshevDistance max(|dx|, |dy|, |dz|)
+func V3IntChebyshevDistance(from, to Vector3Int) int {
+	return _IntMax(_IntMax(_IntAbs(to.X-from.X), _IntAbs(to.Y-from.Y)), _IntAbs(to.Z-from.Z))
+}
@@ -1,5 +1,7 @@
 package gmath
 
+import "math"
+
 type Vector4 struct {
 	X float32 `json:"x"`
 	Y float32 `json:"y"`
@@ -7,6 +9,135 @@ type Vector4 struct {
 	W float32 `json:"w"`
 }
 
+func V4Zero() Vector4 { return Vector4{0, 0, 0, 0} }
+func V4One() Vector4  { return Vector4{1, 1, 1, 1} }
+
+// V4FromPoint w = 1
+func V4FromPoint(v Vector3) Vector4 { return Vector4{v.X, v.Y, v.Z, 1} }
+
+// V4FromDirection w = 0
+func V4FromDirection(v Vector3) Vector4 { return Vector4{v.X, v.Y, v.Z, 0} }
+
+func (v4 *Vector4) Set(x, y, z, w float32) {
+	v4.X = x
+	v4.Y = y
+	v4.Z = z
+	v4.W = w
+}
+
 func (v4 Vector4) XYZ() Vector3 {
 	return Vector3{v4.X, v4.Y, v4.Z}
 }
+
+// PerspectiveDivide XYZ / W, return XYZ if W is zero
+func (v4 Vector4) PerspectiveDivide() Vector3 {
+	if F32IsZero(v4.W) {
+		return v4.XYZ()
+	}
+	num := 1 / v4.W
+	return Vector3{v4.X * num, v4.Y * num, v4.Z * num}
+}
+
+func (v4 *Vector4) Add(v Vector4) Vector4 {
+	return Vector4{v4.X + v.X, v4.Y + v.Y, v4.Z + v.Z, v4.W + v.W}
+}
+
+func (v4 *Vector4) AddSelf(v Vector4) {
+	v4.X += v.X
+	v4.Y += v.Y
+	v4.Z += v.Z
+	v4.W += v.W
+}
+
+func (v4 Vector4) Substract(v Vector4) Vector4 {
+	return Vector4{v4.X - v.X, v4.Y - v.Y, v4.Z - v.Z, v4.W - v.W}
+}
+
+func (v4 Vector4) Scale(v float32) Vector4 {
+	return Vector4{v4.X * v, v4.Y * v, v4.Z * v, v4.W * v}
+}
+
+func (v4 *Vector4) ScaleSelf(v float32) {
+	v4.X *= v
+	v4.Y *= v
+	v4.Z *= v
+	v4.W *= v
+}
+
+func (v4 Vector4) ScaleV4(v Vector4) Vector4 {
+	return Vector4{v4.X * v.X, v4.Y * v.Y, v4.Z * v.Z, v4.W * v.W}
+}
+
+func (v4 Vector4) Dot(v Vector4) float32 {
+	return v4.X*v.X + v4.Y*v.Y + v4.Z*v.Z + v4.W*v.W
+}
+
+func (v4 Vector4) Magnitude() float32 {
+	return F32Sqrt(v4.X*v4.X + v4.Y*v4.Y + v4.Z*v4.Z + v4.W*v4.W)
+}
+
+func (v4 Vector4) SqrMagnitude() float32 {
+	return v4.X*v4.X + v4.Y*v4.Y + v4.Z*v4.Z + v4.W*v4.W
+}
+
+func (v4 Vector4) Normalize() Vector4 {
+	v4.NormalizeSelf()
+	return v4
+}
+
+func (v4 *Vector4) NormalizeSelf() float32 {
+	var magn = v4.Magnitude()
+	if F32IsZero(magn) {
+		return 0
+	}
+	v4.X = v4.X / magn
+	v4.Y = v4.Y / magn
+	v4.Z = v4.Z / magn
+	v4.W = v4.W / magn
+	return magn
+}
+
+func (v4 Vector4) IsZero() bool {
+	return F32IsZero(v4.SqrMagnitude())
+}
+
+func (v4 Vector4) Equal(v Vector4) bool {
+	return F32Equal(v4.X, v.X) && F32Equal(v4.Y, v.Y) && F32Equal(v4.Z, v.Z) && F32Equal(v4.W, v.W)
+}
+
+func (v4 Vector4) IsValid() bool {
+	return !(math.IsNaN(float64(v4.X)) || math.IsNaN(float64(v4.Y)) || math.IsNaN(float64(v4.Z)) || math.IsNaN(float64(v4.W)))
+}
+
+//========================
+
+func V4Lerp(from Vector4, to Vector4, t float32) Vector4 {
+	t = F32Clamp01(t)
+	return Vector4{from.X + (to.X-from.X)*t, from.Y + (to.Y-from.Y)*t, from.Z + (to.Z-from.Z)*t, from.W + (to.W-from.W)*t}
+}
+
+func V4LerpUnclamped(from Vector4, to Vector4, t float32) Vector4 {
+	return Vector4{from.X + (to.X-from.X)*t, from.Y + (to.Y-from.Y)*t, from.Z + (to.Z-from.Z)*t, from.W + (to.W-from.W)*t}
+}
+
+func V4MoveTowards(current Vector4, target Vector4, maxDistanceDelta float32) Vector4 {
+	num := target.X - current.X
+	num2 := target.Y - current.Y
+	num3 := target.Z - current.Z
+	num4 := target.W - current.W
+	num5 := num*num + num2*num2 + num3*num3 + num4*num4
+
+	if num5 == 0 || (maxDistanceDelta >= 0 && num5 <= maxDistanceDelta*maxDistanceDelta) {
+		return target
+	}
+	num6 := F32Sqrt(num5)
+	return Vector4{current.X + num/num6*maxDistanceDelta, current.Y + num2/num6*maxDistanceDelta, current.Z + num3/num6*maxDistanceDelta, current.W + num4/num6*maxDistanceDelta}
+}
+
+func V4Distance(from, to Vector4) float32 {
+	return to.Substract(from).Magnitude()
+}
+
+func V4DistanceSqr(from, to Vector4) float32 {
+	return to.Substract(from).SqrMagnitude()
+}
@@ -0,0 +1,23 @@
+package gmath
+
+import "testing"
+
+func TestVector4(t *testing.T) {
+	m := Matrix4TRS(Vector3{1, 2, 3}, QuaternionFromEulerAngle(Vector3{10, 20, 30}), Vector3{2, 2, 2})
+	p := Vector3{4, 5, 6}
+
+	v := m.MultiplyPoint4(V4FromPoint(p))
+	if !v.PerspectiveDivide().Equal(m.MultiplyPoint3(p)) {
+		t.Error("V4FromPoint")
+	}
+
+	v = m.MultiplyPoint4(V4FromDirection(p))
+	if !v.XYZ().Equal(m.MultiplyDir3(p)) {
+		t.Error("V4FromDirection")
+	}
+
+	v = V4MoveTowards(V4Zero(), Vector4{1, 1, 1, 1}, 1)
+	if !F32Equal(v.Magnitude(), 1) || !v.Equal(Vector4{0.5, 0.5, 0.5, 0.5}) {
+		t.Error("V4MoveTowards")
+	}
+}